@@ -0,0 +1,155 @@
+package genorm
+
+import "fmt"
+
+/*
+Alias
+name of the table alias.
+Define a type for each alias so that the aliased tables are distinct types.
+e.g.
+
+	type Manager struct{}
+
+	func (Manager) AliasName() string {
+		return "manager"
+	}
+*/
+type Alias interface {
+	AliasName() string
+}
+
+/*
+AliasedTable
+table_name AS alias_name
+*/
+type AliasedTable[S any, T BasicTablePointer[S], A Alias] struct {
+	table S
+}
+
+// As table_name AS alias_name
+func As[A Alias, S any, T BasicTablePointer[S]](table T) *AliasedTable[S, T, A] {
+	aliasedTable := &AliasedTable[S, T, A]{}
+	if table != nil {
+		aliasedTable.table = *table
+	}
+
+	return aliasedTable
+}
+
+// Table original table
+func (a *AliasedTable[S, T, A]) Table() T {
+	return T(&a.table)
+}
+
+// TableName alias_name
+func (*AliasedTable[_, _, A]) TableName() string {
+	var alias A
+	return alias.AliasName()
+}
+
+func (a *AliasedTable[S, T, _]) Expr() (string, []ExprType, []error) {
+	errs := a.GetErrors()
+	if len(errs) != 0 {
+		return "", nil, errs
+	}
+
+	return fmt.Sprintf("%s AS %s", T(&a.table).TableName(), a.TableName()), nil, nil
+}
+
+func (a *AliasedTable[S, T, _]) Columns() []Column {
+	baseColumns := T(&a.table).Columns()
+
+	columns := make([]Column, 0, len(baseColumns))
+	for _, column := range baseColumns {
+		columns = append(columns, &aliasedColumn{
+			aliasName: a.TableName(),
+			column:    column,
+		})
+	}
+
+	return columns
+}
+
+// ColumnMap key: alias_name.column_name
+func (a *AliasedTable[S, T, _]) ColumnMap() map[string]ColumnFieldExprType {
+	table := T(&a.table)
+	baseColumnMap := table.ColumnMap()
+
+	columnMap := make(map[string]ColumnFieldExprType, len(baseColumnMap))
+	for _, column := range table.Columns() {
+		field, ok := baseColumnMap[column.SQLColumnName()]
+		if !ok {
+			continue
+		}
+
+		columnMap[fmt.Sprintf("%s.%s", a.TableName(), column.ColumnName())] = field
+	}
+
+	return columnMap
+}
+
+func (a *AliasedTable[S, T, _]) GetErrors() []error {
+	errs := T(&a.table).GetErrors()
+
+	aliasName := a.TableName()
+	if !identifierRegexp.MatchString(aliasName) {
+		errs = append(errs, fmt.Errorf("invalid alias name: %s", aliasName))
+	}
+
+	return errs
+}
+
+type aliasedColumn struct {
+	aliasName string
+	column    Column
+}
+
+func (c *aliasedColumn) Expr() (string, []ExprType, []error) {
+	return c.SQLColumnName(), nil, nil
+}
+
+// SQLColumnName alias_name.column_name
+func (c *aliasedColumn) SQLColumnName() string {
+	return fmt.Sprintf("%s.%s", c.aliasName, c.column.ColumnName())
+}
+
+// TableName alias_name
+func (c *aliasedColumn) TableName() string {
+	return c.aliasName
+}
+
+func (c *aliasedColumn) ColumnName() string {
+	return c.column.ColumnName()
+}
+
+type aliasedTypedColumn[S any, T BasicTablePointer[S], A Alias, U ExprType] struct {
+	aliasedColumn
+}
+
+func newAliasedTypedColumn[A Alias, S any, T BasicTablePointer[S], U ExprType](column TypedTableColumns[T, U]) *aliasedTypedColumn[S, T, A, U] {
+	var alias A
+	return &aliasedTypedColumn[S, T, A, U]{
+		aliasedColumn: aliasedColumn{
+			aliasName: alias.AliasName(),
+			column:    column,
+		},
+	}
+}
+
+func (c *aliasedTypedColumn[S, T, A, _]) TableExpr(*AliasedTable[S, T, A]) (string, []ExprType, []error) {
+	return c.Expr()
+}
+
+func (c *aliasedTypedColumn[_, _, _, U]) TypedExpr(U) (string, []ExprType, []error) {
+	return c.Expr()
+}
+
+// AliasColumn alias_name.column_name
+func AliasColumn[A Alias, S any, T BasicTablePointer[S], U ExprType](column TypedTableColumns[T, U]) TypedTableColumns[*AliasedTable[S, T, A], U] {
+	return newAliasedTypedColumn[A](column)
+}
+
+// AliasExpr alias_name.column_name
+func AliasExpr[A Alias, S any, T BasicTablePointer[S], U ExprType](column TypedTableColumns[T, U]) TypedTableExpr[*AliasedTable[S, T, A], U] {
+	return newAliasedTypedColumn[A](column)
+}
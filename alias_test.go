@@ -0,0 +1,165 @@
+package genorm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mazrean/genorm"
+	"github.com/mazrean/genorm/relation"
+	"github.com/stretchr/testify/assert"
+)
+
+type aliasTestUser struct {
+	ID genorm.WrappedPrimitive[int64]
+}
+
+func (*aliasTestUser) TableName() string {
+	return "users"
+}
+
+func (t *aliasTestUser) Expr() (string, []genorm.ExprType, []error) {
+	return t.TableName(), nil, nil
+}
+
+func (*aliasTestUser) Columns() []genorm.Column {
+	return []genorm.Column{aliasTestUserID}
+}
+
+func (t *aliasTestUser) ColumnMap() map[string]genorm.ColumnFieldExprType {
+	return map[string]genorm.ColumnFieldExprType{
+		aliasTestUserID.SQLColumnName(): &t.ID,
+	}
+}
+
+func (*aliasTestUser) GetErrors() []error {
+	return nil
+}
+
+type aliasTestUserIDColumn struct{}
+
+var aliasTestUserID genorm.TypedTableColumns[*aliasTestUser, genorm.WrappedPrimitive[int64]] = aliasTestUserIDColumn{}
+
+func (c aliasTestUserIDColumn) Expr() (string, []genorm.ExprType, []error) {
+	return c.SQLColumnName(), nil, nil
+}
+
+func (c aliasTestUserIDColumn) SQLColumnName() string {
+	return fmt.Sprintf("%s.%s", c.TableName(), c.ColumnName())
+}
+
+func (aliasTestUserIDColumn) TableName() string {
+	return "users"
+}
+
+func (aliasTestUserIDColumn) ColumnName() string {
+	return "id"
+}
+
+func (c aliasTestUserIDColumn) TableExpr(*aliasTestUser) (string, []genorm.ExprType, []error) {
+	return c.Expr()
+}
+
+func (c aliasTestUserIDColumn) TypedExpr(genorm.WrappedPrimitive[int64]) (string, []genorm.ExprType, []error) {
+	return c.Expr()
+}
+
+type aliasTestManager struct{}
+
+func (aliasTestManager) AliasName() string {
+	return "manager"
+}
+
+type aliasTestInvalid struct{}
+
+func (aliasTestInvalid) AliasName() string {
+	return "manager; DROP TABLE users"
+}
+
+func TestAliasedTable(t *testing.T) {
+	t.Parallel()
+
+	table := genorm.As[aliasTestManager](&aliasTestUser{})
+
+	assert.Equal(t, "manager", table.TableName())
+
+	query, args, errs := table.Expr()
+	assert.Empty(t, errs)
+	assert.Equal(t, "users AS manager", query)
+	assert.Empty(t, args)
+
+	columns := table.Columns()
+	if assert.Len(t, columns, 1) {
+		assert.Equal(t, "manager.id", columns[0].SQLColumnName())
+		assert.Equal(t, "manager", columns[0].TableName())
+		assert.Equal(t, "id", columns[0].ColumnName())
+	}
+
+	columnMap := table.ColumnMap()
+	if assert.Len(t, columnMap, 1) {
+		assert.Same(t, &table.Table().ID, columnMap["manager.id"])
+	}
+
+	assert.Empty(t, table.GetErrors())
+}
+
+func TestAliasedTableInvalidAlias(t *testing.T) {
+	t.Parallel()
+
+	table := genorm.As[aliasTestInvalid](&aliasTestUser{})
+
+	assert.Len(t, table.GetErrors(), 1)
+
+	_, _, errs := table.Expr()
+	assert.Len(t, errs, 1)
+}
+
+func TestAliasColumn(t *testing.T) {
+	t.Parallel()
+
+	column := genorm.AliasColumn[aliasTestManager](aliasTestUserID)
+
+	query, args, errs := column.Expr()
+	assert.Empty(t, errs)
+	assert.Equal(t, "manager.id", query)
+	assert.Empty(t, args)
+
+	assert.Equal(t, "manager.id", column.SQLColumnName())
+	assert.Equal(t, "manager", column.TableName())
+	assert.Equal(t, "id", column.ColumnName())
+
+	expr := genorm.EqLit(genorm.AliasExpr[aliasTestManager](aliasTestUserID), genorm.Wrap[int64](1))
+	query, args, errs = expr.Expr()
+	assert.Empty(t, errs)
+	assert.Equal(t, "(manager.id = ?)", query)
+	assert.Equal(t, []genorm.ExprType{genorm.Wrap[int64](1)}, args)
+}
+
+func TestSelfJoinSelect(t *testing.T) {
+	t.Parallel()
+
+	pair := relation.Pair(&aliasTestUser{}, genorm.As[aliasTestManager](&aliasTestUser{}))
+	joinedTable := pair.Join(genorm.Eq(
+		relation.LeftExpr(pair, aliasTestUserID),
+		relation.RightExpr(pair, genorm.AliasColumn[aliasTestManager](aliasTestUserID)),
+	))
+
+	columns, query, args, err := genorm.
+		Select(joinedTable).
+		Fields(
+			relation.Left(pair, aliasTestUserID),
+			relation.Right(pair, genorm.AliasColumn[aliasTestManager](aliasTestUserID)),
+		).
+		BuildQuery()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "SELECT users.id AS users_id_0, manager.id AS manager_id_0 FROM (users INNER JOIN users AS manager ON (users.id = manager.id))", query)
+	assert.Empty(t, args)
+
+	columnMap := joinedTable.ColumnMap()
+	if assert.Len(t, columns, 2) {
+		assert.Same(t, &joinedTable.Left().ID, columnMap[columns[0].SQLColumnName()])
+		assert.Same(t, &joinedTable.Right().Table().ID, columnMap[columns[1].SQLColumnName()])
+	}
+}
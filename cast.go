@@ -0,0 +1,110 @@
+package genorm
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+var (
+	castTypeLocker = sync.RWMutex{}
+	castTypeMap    = map[Dialect]map[reflect.Type]string{
+		MySQL: {
+			reflect.TypeFor[WrappedPrimitive[bool]]():      "SIGNED",
+			reflect.TypeFor[WrappedPrimitive[int]]():       "SIGNED",
+			reflect.TypeFor[WrappedPrimitive[int8]]():      "SIGNED",
+			reflect.TypeFor[WrappedPrimitive[int16]]():     "SIGNED",
+			reflect.TypeFor[WrappedPrimitive[int32]]():     "SIGNED",
+			reflect.TypeFor[WrappedPrimitive[int64]]():     "SIGNED",
+			reflect.TypeFor[WrappedPrimitive[uint]]():      "UNSIGNED",
+			reflect.TypeFor[WrappedPrimitive[uint8]]():     "UNSIGNED",
+			reflect.TypeFor[WrappedPrimitive[uint16]]():    "UNSIGNED",
+			reflect.TypeFor[WrappedPrimitive[uint32]]():    "UNSIGNED",
+			reflect.TypeFor[WrappedPrimitive[uint64]]():    "UNSIGNED",
+			reflect.TypeFor[WrappedPrimitive[float32]]():   "FLOAT",
+			reflect.TypeFor[WrappedPrimitive[float64]]():   "DOUBLE",
+			reflect.TypeFor[WrappedPrimitive[string]]():    "CHAR",
+			reflect.TypeFor[WrappedPrimitive[time.Time]](): "DATETIME",
+		},
+		PostgreSQL: {
+			reflect.TypeFor[WrappedPrimitive[bool]]():      "BOOLEAN",
+			reflect.TypeFor[WrappedPrimitive[int]]():       "BIGINT",
+			reflect.TypeFor[WrappedPrimitive[int8]]():      "SMALLINT",
+			reflect.TypeFor[WrappedPrimitive[int16]]():     "SMALLINT",
+			reflect.TypeFor[WrappedPrimitive[int32]]():     "INTEGER",
+			reflect.TypeFor[WrappedPrimitive[int64]]():     "BIGINT",
+			reflect.TypeFor[WrappedPrimitive[uint]]():      "NUMERIC(20)",
+			reflect.TypeFor[WrappedPrimitive[uint8]]():     "SMALLINT",
+			reflect.TypeFor[WrappedPrimitive[uint16]]():    "INTEGER",
+			reflect.TypeFor[WrappedPrimitive[uint32]]():    "BIGINT",
+			reflect.TypeFor[WrappedPrimitive[uint64]]():    "NUMERIC(20)",
+			reflect.TypeFor[WrappedPrimitive[float32]]():   "REAL",
+			reflect.TypeFor[WrappedPrimitive[float64]]():   "DOUBLE PRECISION",
+			reflect.TypeFor[WrappedPrimitive[string]]():    "TEXT",
+			reflect.TypeFor[WrappedPrimitive[time.Time]](): "TIMESTAMP",
+		},
+	}
+)
+
+// RegisterCastType register SQL type name used by Cast for the expression type S
+func RegisterCastType[S ExprType](dialect Dialect, sqlType string) error {
+	err := dialect.validate()
+	if err != nil {
+		return err
+	}
+
+	if len(sqlType) == 0 {
+		return errors.New("empty sql type")
+	}
+
+	castTypeLocker.Lock()
+	defer castTypeLocker.Unlock()
+
+	castTypeMap[dialect][reflect.TypeFor[S]()] = sqlType
+
+	return nil
+}
+
+func castType[S ExprType](dialect Dialect) (string, error) {
+	err := dialect.validate()
+	if err != nil {
+		return "", err
+	}
+
+	castTypeLocker.RLock()
+	defer castTypeLocker.RUnlock()
+
+	sqlType, ok := castTypeMap[dialect][reflect.TypeFor[S]()]
+	if !ok {
+		return "", fmt.Errorf("sql type for %s is not registered", reflect.TypeFor[S]())
+	}
+
+	return sqlType, nil
+}
+
+// Cast CAST(expr AS type), the type is the one registered for the dialect of the query builder
+func Cast[T Table, From ExprType, To ExprType](expr TypedTableExpr[T, From]) TypedTableExpr[T, To] {
+	if expr == nil {
+		return &ExprStruct[T, To]{
+			errs: []error{errors.New("cast expr is nil")},
+		}
+	}
+
+	query, args, errs := expr.Expr()
+	if len(errs) != 0 {
+		return &ExprStruct[T, To]{
+			errs: errs,
+		}
+	}
+
+	return newDialectExpr[T, To](func(dialect Dialect) (string, []ExprType, error) {
+		sqlType, err := castType[To](dialect)
+		if err != nil {
+			return "", nil, fmt.Errorf("cast type: %w", err)
+		}
+
+		return fmt.Sprintf("CAST(%s AS %s)", query, sqlType), args, nil
+	})
+}
@@ -0,0 +1,183 @@
+package genorm_test
+
+import (
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mazrean/genorm"
+	"github.com/mazrean/genorm/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type castTestJSON struct{}
+
+func (castTestJSON) Value() (driver.Value, error) {
+	return "{}", nil
+}
+
+type castTestUnregistered struct{}
+
+func (castTestUnregistered) Value() (driver.Value, error) {
+	return nil, nil
+}
+
+func TestRegisterCastType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		dialect     genorm.Dialect
+		sqlType     string
+		isError     bool
+	}{
+		{
+			description: "mysql",
+			dialect:     genorm.MySQL,
+			sqlType:     "JSON",
+		},
+		{
+			description: "postgres",
+			dialect:     genorm.PostgreSQL,
+			sqlType:     "JSONB",
+		},
+		{
+			description: "invalid dialect",
+			sqlType:     "JSON",
+			isError:     true,
+		},
+		{
+			description: "empty sql type",
+			dialect:     genorm.MySQL,
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			err := genorm.RegisterCastType[castTestJSON](test.dialect, test.sqlType)
+			if test.isError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCast(t *testing.T) {
+	t.Parallel()
+
+	err := genorm.RegisterCastType[castTestJSON](genorm.MySQL, "JSON")
+	if err != nil {
+		t.Fatalf("failed to register cast type: %v", err)
+	}
+
+	tests := []struct {
+		description   string
+		dialect       genorm.Dialect
+		cast          func(genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr
+		exprIsNil     bool
+		exprErrs      []error
+		expectedQuery string
+		isError       bool
+	}{
+		{
+			description: "mysql string",
+			dialect:     genorm.MySQL,
+			cast: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr {
+				return genorm.Cast[*mock.MockTable, genorm.WrappedPrimitive[int64], genorm.WrappedPrimitive[string]](expr)
+			},
+			expectedQuery: "CAST((hoge.huga + ?) AS CHAR)",
+		},
+		{
+			description: "postgres string",
+			dialect:     genorm.PostgreSQL,
+			cast: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr {
+				return genorm.Cast[*mock.MockTable, genorm.WrappedPrimitive[int64], genorm.WrappedPrimitive[string]](expr)
+			},
+			expectedQuery: "CAST((hoge.huga + ?) AS TEXT)",
+		},
+		{
+			description: "postgres float64",
+			dialect:     genorm.PostgreSQL,
+			cast: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr {
+				return genorm.Cast[*mock.MockTable, genorm.WrappedPrimitive[int64], genorm.WrappedPrimitive[float64]](expr)
+			},
+			expectedQuery: "CAST((hoge.huga + ?) AS DOUBLE PRECISION)",
+		},
+		{
+			description: "registered custom type",
+			dialect:     genorm.MySQL,
+			cast: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr {
+				return genorm.Cast[*mock.MockTable, genorm.WrappedPrimitive[int64], castTestJSON](expr)
+			},
+			expectedQuery: "CAST((hoge.huga + ?) AS JSON)",
+		},
+		{
+			description: "unregistered type",
+			dialect:     genorm.MySQL,
+			cast: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr {
+				return genorm.Cast[*mock.MockTable, genorm.WrappedPrimitive[int64], castTestUnregistered](expr)
+			},
+			isError: true,
+		},
+		{
+			description: "invalid dialect",
+			cast: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr {
+				return genorm.Cast[*mock.MockTable, genorm.WrappedPrimitive[int64], genorm.WrappedPrimitive[string]](expr)
+			},
+			isError: true,
+		},
+		{
+			description: "nil expr",
+			dialect:     genorm.MySQL,
+			cast: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr {
+				return genorm.Cast[*mock.MockTable, genorm.WrappedPrimitive[int64], genorm.WrappedPrimitive[string]](expr)
+			},
+			exprIsNil: true,
+			isError:   true,
+		},
+		{
+			description: "expr error",
+			dialect:     genorm.MySQL,
+			cast: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr {
+				return genorm.Cast[*mock.MockTable, genorm.WrappedPrimitive[int64], genorm.WrappedPrimitive[string]](expr)
+			},
+			exprErrs: []error{errors.New("expr error")},
+			isError:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]
+			if !test.exprIsNil {
+				mockExpr := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[int64]](ctrl)
+				expr = mockExpr
+
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return("(hoge.huga + ?)", []genorm.ExprType{genorm.Wrap(int64(1))}, test.exprErrs).
+					AnyTimes()
+			}
+
+			query, args, err := resolveExpr(test.dialect, test.cast(expr))
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
+			assert.Equal(t, []genorm.ExprType{genorm.Wrap(int64(1))}, args)
+		})
+	}
+}
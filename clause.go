@@ -3,36 +3,90 @@ package genorm
 import (
 	"errors"
 	"fmt"
+	"math"
+	"slices"
 	"strings"
 )
 
+/*
+whereConditionClause
+The conditions are combined when the query is built,
+so that the conditions added to Conditions after Where are not lost.
+*/
 type whereConditionClause[T Table] struct {
+	conditions []whereConditionItem[T]
+}
+
+type whereConditionItem[T Table] struct {
+	isOr      bool
 	condition TypedTableExpr[T, WrappedPrimitive[bool]]
 }
 
+// set sets condition, or ANDs condition onto the condition already set
 func (c *whereConditionClause[T]) set(condition TypedTableExpr[T, WrappedPrimitive[bool]]) error {
-	if c.condition != nil {
-		return errors.New("where conditions already set")
+	if condition == nil {
+		return errors.New("empty where condition")
 	}
+
+	c.conditions = append(slices.Clip(c.conditions), whereConditionItem[T]{
+		condition: condition,
+	})
+
+	return nil
+}
+
+// or sets condition, or ORs condition onto the condition already set
+func (c *whereConditionClause[T]) or(condition TypedTableExpr[T, WrappedPrimitive[bool]]) error {
 	if condition == nil {
 		return errors.New("empty where condition")
 	}
 
-	c.condition = condition
+	c.conditions = append(slices.Clip(c.conditions), whereConditionItem[T]{
+		isOr:      true,
+		condition: condition,
+	})
 
 	return nil
 }
 
+// getCondition conditions combined in the order of set/or. nil if all conditions are empty.
+func (c *whereConditionClause[T]) getCondition() TypedTableExpr[T, WrappedPrimitive[bool]] {
+	var condition TypedTableExpr[T, WrappedPrimitive[bool]]
+	for _, item := range c.conditions {
+		if ec, ok := item.condition.(emptiableCondition); ok && ec.isEmpty() {
+			continue
+		}
+
+		switch {
+		case condition == nil:
+			condition = item.condition
+		case item.isOr:
+			condition = Or(condition, item.condition)
+		default:
+			condition = And(condition, item.condition)
+		}
+	}
+
+	return condition
+}
+
 func (c *whereConditionClause[T]) exists() bool {
-	return c.condition != nil
+	for _, item := range c.conditions {
+		if ec, ok := item.condition.(emptiableCondition); !ok || !ec.isEmpty() {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (c *whereConditionClause[T]) getExpr() (string, []ExprType, error) {
-	if c.condition == nil {
+	condition := c.getCondition()
+	if condition == nil {
 		return "", nil, errors.New("empty where condition")
 	}
 
-	query, args, errs := c.condition.Expr()
+	query, args, errs := condition.Expr()
 	if len(errs) != 0 {
 		return "", nil, errs[0]
 	}
@@ -106,7 +160,8 @@ func (c *orderClause[T]) add(item orderItem[T]) error {
 		return errors.New("invalid order direction")
 	}
 
-	c.orderExprs = append(c.orderExprs, item)
+	// clip so that clones of the clause do not share the backing array
+	c.orderExprs = append(slices.Clip(c.orderExprs), item)
 
 	return nil
 }
@@ -147,27 +202,27 @@ func (c *orderClause[T]) getExpr() (string, []ExprType, error) {
 
 type limitClause struct {
 	limit uint64
+	isSet bool
 }
 
+// set overrides the limit. 0 is rendered as LIMIT 0.
 func (l *limitClause) set(limit uint64) error {
-	if l.limit != 0 {
-		return errors.New("limit already set")
-	}
-	if limit == 0 {
-		return errors.New("invalid limit")
+	if limit > math.MaxInt64 {
+		return fmt.Errorf("limit %d is larger than the maximum %d", limit, uint64(math.MaxInt64))
 	}
 
 	l.limit = limit
+	l.isSet = true
 
 	return nil
 }
 
 func (l *limitClause) exists() bool {
-	return l.limit != 0
+	return l.isSet
 }
 
 func (l *limitClause) getExpr() (string, []ExprType, error) {
-	if l.limit == 0 {
+	if !l.isSet {
 		return "", nil, errors.New("empty limit")
 	}
 
@@ -176,27 +231,27 @@ func (l *limitClause) getExpr() (string, []ExprType, error) {
 
 type offsetClause struct {
 	offset uint64
+	isSet  bool
 }
 
+// set overrides the offset. 0 is rendered as OFFSET 0.
 func (o *offsetClause) set(offset uint64) error {
-	if o.offset != 0 {
-		return errors.New("offset already set")
-	}
-	if offset == 0 {
-		return errors.New("invalid offset")
+	if offset > math.MaxInt64 {
+		return fmt.Errorf("offset %d is larger than the maximum %d", offset, uint64(math.MaxInt64))
 	}
 
 	o.offset = offset
+	o.isSet = true
 
 	return nil
 }
 
 func (o *offsetClause) exists() bool {
-	return o.offset != 0
+	return o.isSet
 }
 
 func (o *offsetClause) getExpr() (string, []ExprType, error) {
-	if o.offset == 0 {
+	if !o.isSet {
 		return "", nil, errors.New("empty offset")
 	}
 
@@ -204,7 +259,9 @@ func (o *offsetClause) getExpr() (string, []ExprType, error) {
 }
 
 type lockClause struct {
-	lockType LockType
+	lockType   LockType
+	waitPolicy lockWaitPolicy
+	tables     []BasicTable
 }
 
 type LockType uint8
@@ -213,18 +270,108 @@ const (
 	none LockType = iota
 	ForUpdate
 	ForShare
+	// ForNoKeyUpdate FOR NO KEY UPDATE(PostgreSQL only)
+	ForNoKeyUpdate
+	// ForKeyShare FOR KEY SHARE(PostgreSQL only)
+	ForKeyShare
+)
+
+func (lt LockType) validate(dialect Dialect) error {
+	switch lt {
+	case ForUpdate, ForShare:
+		return nil
+	case ForNoKeyUpdate, ForKeyShare:
+		if dialect != PostgreSQL {
+			return fmt.Errorf("lock type %d is supported only in PostgreSQL", lt)
+		}
+
+		return nil
+	}
+
+	return errors.New("invalid lock type")
+}
+
+type lockWaitPolicy uint8
+
+const (
+	wait lockWaitPolicy = iota
+	skipLocked
+	noWait
 )
 
-func (l *lockClause) set(lockType LockType) error {
+// LockOption option of the lock clause
+type LockOption func(*lockClause) error
+
+// SkipLocked SKIP LOCKED
+func SkipLocked() LockOption {
+	return func(l *lockClause) error {
+		return l.setWaitPolicy(skipLocked)
+	}
+}
+
+// NoWait NOWAIT
+func NoWait() LockOption {
+	return func(l *lockClause) error {
+		return l.setWaitPolicy(noWait)
+	}
+}
+
+/*
+LockOf OF table1, table2, ...
+tables must be the table or one of BaseTables() of the joined table.
+*/
+func LockOf(tables ...BasicTable) LockOption {
+	return func(l *lockClause) error {
+		if len(tables) == 0 {
+			return errors.New("no lock tables")
+		}
+		if len(l.tables) != 0 {
+			return errors.New("lock tables already set")
+		}
+
+		for _, table := range tables {
+			if table == nil {
+				return errors.New("nil lock table")
+			}
+		}
+
+		l.tables = tables
+
+		return nil
+	}
+}
+
+func (l *lockClause) set(lockType LockType, options ...LockOption) error {
 	if l.lockType != none {
 		return errors.New("lock type already set")
 	}
-	if lockType != ForUpdate && lockType != ForShare {
+	if lockType == none || lockType > ForKeyShare {
 		return errors.New("invalid lock type")
 	}
 
 	l.lockType = lockType
 
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+
+		err := option(l)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l *lockClause) setWaitPolicy(waitPolicy lockWaitPolicy) error {
+	if l.waitPolicy != wait {
+		return errors.New("skip locked or nowait already set")
+	}
+
+	l.waitPolicy = waitPolicy
+
 	return nil
 }
 
@@ -232,15 +379,93 @@ func (l *lockClause) exists() bool {
 	return l.lockType != none
 }
 
+// validate check the lock type for dialect and that the lock tables are in table
+func (l *lockClause) validate(dialect Dialect, table Table) error {
+	err := l.lockType.validate(dialect)
+	if err != nil {
+		return err
+	}
+
+	if len(l.tables) == 0 {
+		return nil
+	}
+
+	var baseTables []BasicTable
+	switch t := table.(type) {
+	case JoinedTable:
+		baseTables = t.BaseTables()
+	case BasicTable:
+		baseTables = []BasicTable{t}
+	}
+
+	tableNames := make(map[string]struct{}, len(baseTables))
+	for _, baseTable := range baseTables {
+		tableNames[baseTable.TableName()] = struct{}{}
+	}
+
+	for _, lockTable := range l.tables {
+		if _, ok := tableNames[lockTable.TableName()]; !ok {
+			return fmt.Errorf("lock table %s is not in the query", lockTable.TableName())
+		}
+	}
+
+	return nil
+}
+
 func (l *lockClause) getExpr() (string, []ExprType, error) {
+	sb := strings.Builder{}
+
+	var str string
 	switch l.lockType {
 	case ForUpdate:
-		return "FOR UPDATE", nil, nil
+		str = "FOR UPDATE"
 	case ForShare:
-		return "FOR SHARE", nil, nil
+		str = "FOR SHARE"
+	case ForNoKeyUpdate:
+		str = "FOR NO KEY UPDATE"
+	case ForKeyShare:
+		str = "FOR KEY SHARE"
 	case none:
 		return "", nil, nil
+	default:
+		return "", nil, errors.New("invalid lock type")
+	}
+
+	_, err := sb.WriteString(str)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+	}
+
+	if len(l.tables) != 0 {
+		tableNames := make([]string, 0, len(l.tables))
+		for _, table := range l.tables {
+			tableNames = append(tableNames, table.TableName())
+		}
+
+		str = " OF " + strings.Join(tableNames, ", ")
+		_, err = sb.WriteString(str)
+		if err != nil {
+			return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+		}
+	}
+
+	switch l.waitPolicy {
+	case wait:
+	case skipLocked:
+		str = " SKIP LOCKED"
+		_, err = sb.WriteString(str)
+		if err != nil {
+			return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+		}
+	case noWait:
+		str = " NOWAIT"
+		_, err = sb.WriteString(str)
+		if err != nil {
+			return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+		}
+	default:
+		return "", nil, errors.New("invalid wait policy")
 	}
 
-	return "", nil, errors.New("invalid lock type")
+	return sb.String(), nil, nil
 }
@@ -2,19 +2,23 @@ package genorm
 
 //nolint:revive
 func NewWhereConditionClause[T Table](condition TypedTableExpr[T, WrappedPrimitive[bool]]) *whereConditionClause[T] {
-	return &whereConditionClause[T]{
-		condition: condition,
+	if condition == nil {
+		return &whereConditionClause[T]{}
 	}
-}
 
-func (c *whereConditionClause[T]) GetCondition() TypedTableExpr[T, WrappedPrimitive[bool]] {
-	return c.condition
+	return &whereConditionClause[T]{
+		conditions: []whereConditionItem[T]{{condition: condition}},
+	}
 }
 
 func (c *whereConditionClause[T]) Set(condition TypedTableExpr[T, WrappedPrimitive[bool]]) error {
 	return c.set(condition)
 }
 
+func (c *whereConditionClause[T]) Or(condition TypedTableExpr[T, WrappedPrimitive[bool]]) error {
+	return c.or(condition)
+}
+
 func (c *whereConditionClause[T]) Exists() bool {
 	return c.exists()
 }
@@ -96,6 +100,7 @@ func (c *orderClause[T]) GetExpr() (string, []ExprType, error) {
 func NewLimitClause(limit uint64) *limitClause {
 	return &limitClause{
 		limit: limit,
+		isSet: limit != 0,
 	}
 }
 
@@ -119,6 +124,7 @@ func (c *limitClause) GetExpr() (string, []ExprType, error) {
 func NewOffsetClause(offset uint64) *offsetClause {
 	return &offsetClause{
 		offset: offset,
+		isSet:  offset != 0,
 	}
 }
 
@@ -149,8 +155,12 @@ func (c *lockClause) GetLockType() LockType {
 	return c.lockType
 }
 
-func (c *lockClause) Set(lockType LockType) error {
-	return c.set(lockType)
+func (c *lockClause) Set(lockType LockType, options ...LockOption) error {
+	return c.set(lockType, options...)
+}
+
+func (c *lockClause) Validate(dialect Dialect, table Table) error {
+	return c.validate(dialect, table)
 }
 
 func (c *lockClause) Exists() bool {
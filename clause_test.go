@@ -2,6 +2,7 @@ package genorm_test
 
 import (
 	"errors"
+	"math"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -14,25 +15,47 @@ func TestWhereConditionClauseSetTest(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		description string
-		beforeExpr  bool
-		setExpr     bool
-		err         bool
+		description   string
+		beforeExpr    bool
+		setExpr       bool
+		isOr          bool
+		expectedQuery string
+		err           bool
 	}{
 		{
-			description: "normal",
-			setExpr:     true,
+			description:   "normal",
+			setExpr:       true,
+			expectedQuery: "(hoge.piyo = ?)",
 		},
 		{
-			description: "condition already set",
-			beforeExpr:  true,
-			setExpr:     true,
-			err:         true,
+			description:   "or without condition",
+			setExpr:       true,
+			isOr:          true,
+			expectedQuery: "(hoge.piyo = ?)",
+		},
+		{
+			description:   "condition already set",
+			beforeExpr:    true,
+			setExpr:       true,
+			expectedQuery: "((hoge.huga = ?) AND (hoge.piyo = ?))",
+		},
+		{
+			description:   "or condition already set",
+			beforeExpr:    true,
+			setExpr:       true,
+			isOr:          true,
+			expectedQuery: "((hoge.huga = ?) OR (hoge.piyo = ?))",
 		},
 		{
 			description: "nil expr",
 			err:         true,
 		},
+		{
+			description: "or nil expr",
+			beforeExpr:  true,
+			isOr:        true,
+			err:         true,
+		},
 	}
 
 	for _, test := range tests {
@@ -44,6 +67,11 @@ func TestWhereConditionClauseSetTest(t *testing.T) {
 				beforeExpr = nil
 			} else {
 				mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return("(hoge.huga = ?)", []genorm.ExprType{genorm.Wrap(1)}, nil).
+					AnyTimes()
 				beforeExpr = mockExpr
 			}
 
@@ -52,12 +80,22 @@ func TestWhereConditionClauseSetTest(t *testing.T) {
 				setExpr = nil
 			} else {
 				mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return("(hoge.piyo = ?)", []genorm.ExprType{genorm.Wrap(2)}, nil).
+					AnyTimes()
 				setExpr = mockExpr
 			}
 
 			c := genorm.NewWhereConditionClause(beforeExpr)
 
-			err := c.Set(setExpr)
+			var err error
+			if test.isOr {
+				err = c.Or(setExpr)
+			} else {
+				err = c.Set(setExpr)
+			}
 
 			if test.err {
 				assert.Error(t, err)
@@ -66,7 +104,12 @@ func TestWhereConditionClauseSetTest(t *testing.T) {
 				return
 			}
 
-			assert.Equal(t, setExpr, c.GetCondition())
+			query, _, err := c.GetExpr()
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
 		})
 	}
 }
@@ -871,21 +914,29 @@ func TestLimitClauseSetTest(t *testing.T) {
 		description string
 		before      uint64
 		set         uint64
+		query       string
 		err         bool
 	}{
 		{
 			description: "normal",
 			set:         1,
+			query:       "LIMIT 1",
 		},
 		{
 			description: "limit already set",
 			before:      1,
 			set:         2,
-			err:         true,
+			query:       "LIMIT 2",
 		},
 		{
 			description: "limit 0",
+			before:      1,
 			set:         0,
+			query:       "LIMIT 0",
+		},
+		{
+			description: "limit too large",
+			set:         math.MaxInt64 + 1,
 			err:         true,
 		},
 	}
@@ -906,6 +957,14 @@ func TestLimitClauseSetTest(t *testing.T) {
 			}
 
 			assert.Equal(t, test.set, c.GetLimit())
+			assert.True(t, c.Exists())
+
+			query, _, err := c.GetExpr()
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
 		})
 	}
 }
@@ -990,21 +1049,29 @@ func TestOffsetClauseSetTest(t *testing.T) {
 		description string
 		before      uint64
 		set         uint64
+		query       string
 		err         bool
 	}{
 		{
 			description: "normal",
 			set:         1,
+			query:       "OFFSET 1",
 		},
 		{
 			description: "offset already set",
 			before:      1,
 			set:         2,
-			err:         true,
+			query:       "OFFSET 2",
 		},
 		{
-			description: "limit 0",
+			description: "offset 0",
+			before:      1,
 			set:         0,
+			query:       "OFFSET 0",
+		},
+		{
+			description: "offset too large",
+			set:         math.MaxInt64 + 1,
 			err:         true,
 		},
 	}
@@ -1025,6 +1092,14 @@ func TestOffsetClauseSetTest(t *testing.T) {
 			}
 
 			assert.Equal(t, test.set, c.GetOffset())
+			assert.True(t, c.Exists())
+
+			query, _, err := c.GetExpr()
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
 		})
 	}
 }
@@ -1109,6 +1184,7 @@ func TestLockClauseSetTest(t *testing.T) {
 		description string
 		before      genorm.LockType
 		set         genorm.LockType
+		options     []genorm.LockOption
 		err         bool
 	}{
 		{
@@ -1130,13 +1206,49 @@ func TestLockClauseSetTest(t *testing.T) {
 			set:         genorm.ForUpdate,
 			err:         true,
 		},
+		{
+			description: "for no key update",
+			set:         genorm.ForNoKeyUpdate,
+		},
+		{
+			description: "for key share",
+			set:         genorm.ForKeyShare,
+		},
+		{
+			description: "skip locked",
+			set:         genorm.ForUpdate,
+			options:     []genorm.LockOption{genorm.SkipLocked()},
+		},
+		{
+			description: "nowait",
+			set:         genorm.ForShare,
+			options:     []genorm.LockOption{genorm.NoWait()},
+		},
+		{
+			description: "skip locked and nowait",
+			set:         genorm.ForUpdate,
+			options:     []genorm.LockOption{genorm.SkipLocked(), genorm.NoWait()},
+			err:         true,
+		},
+		{
+			description: "lock of no tables",
+			set:         genorm.ForUpdate,
+			options:     []genorm.LockOption{genorm.LockOf()},
+			err:         true,
+		},
+		{
+			description: "lock of nil table",
+			set:         genorm.ForUpdate,
+			options:     []genorm.LockOption{genorm.LockOf(nil)},
+			err:         true,
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			c := genorm.NewLockClause(test.before)
 
-			err := c.Set(test.set)
+			err := c.Set(test.set, test.options...)
 
 			if test.err {
 				assert.Error(t, err)
@@ -1194,6 +1306,8 @@ func TestLockClauseGetExprTest(t *testing.T) {
 	tests := []struct {
 		description string
 		lockType    genorm.LockType
+		options     []genorm.LockOption
+		lockTables  []string
 		query       string
 		args        []genorm.ExprType
 		err         bool
@@ -1217,11 +1331,72 @@ func TestLockClauseGetExprTest(t *testing.T) {
 			description: "empty lock type",
 			query:       "",
 		},
+		{
+			description: "for no key update",
+			lockType:    genorm.ForNoKeyUpdate,
+			query:       "FOR NO KEY UPDATE",
+		},
+		{
+			description: "for key share",
+			lockType:    genorm.ForKeyShare,
+			query:       "FOR KEY SHARE",
+		},
+		{
+			description: "skip locked",
+			lockType:    genorm.ForUpdate,
+			options:     []genorm.LockOption{genorm.SkipLocked()},
+			query:       "FOR UPDATE SKIP LOCKED",
+		},
+		{
+			description: "nowait",
+			lockType:    genorm.ForShare,
+			options:     []genorm.LockOption{genorm.NoWait()},
+			query:       "FOR SHARE NOWAIT",
+		},
+		{
+			description: "lock of",
+			lockType:    genorm.ForUpdate,
+			lockTables:  []string{"messages"},
+			query:       "FOR UPDATE OF messages",
+		},
+		{
+			description: "lock of multiple tables and skip locked",
+			lockType:    genorm.ForUpdate,
+			options:     []genorm.LockOption{genorm.SkipLocked()},
+			lockTables:  []string{"messages", "users"},
+			query:       "FOR UPDATE OF messages, users SKIP LOCKED",
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
 			c := genorm.NewLockClause(test.lockType)
+			if len(test.options) != 0 || len(test.lockTables) != 0 {
+				options := test.options
+				if len(test.lockTables) != 0 {
+					tables := make([]genorm.BasicTable, 0, len(test.lockTables))
+					for _, tableName := range test.lockTables {
+						table := mock.NewMockBasicTable(ctrl)
+						table.
+							EXPECT().
+							TableName().
+							Return(tableName).
+							AnyTimes()
+
+						tables = append(tables, table)
+					}
+
+					options = append(options, genorm.LockOf(tables...))
+				}
+
+				c = genorm.NewLockClause(genorm.LockType(0))
+				err := c.Set(test.lockType, options...)
+				if !assert.NoError(t, err) {
+					return
+				}
+			}
 
 			query, args, err := c.GetExpr()
 
@@ -1239,3 +1414,135 @@ func TestLockClauseGetExprTest(t *testing.T) {
 		})
 	}
 }
+
+func TestLockClauseValidateTest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		lockType    genorm.LockType
+		dialect     genorm.Dialect
+		joined      bool
+		tableNames  []string
+		lockTables  []string
+		err         bool
+	}{
+		{
+			description: "for update mysql",
+			lockType:    genorm.ForUpdate,
+			dialect:     genorm.MySQL,
+			tableNames:  []string{"messages"},
+		},
+		{
+			description: "for no key update postgres",
+			lockType:    genorm.ForNoKeyUpdate,
+			dialect:     genorm.PostgreSQL,
+			tableNames:  []string{"messages"},
+		},
+		{
+			description: "for no key update mysql",
+			lockType:    genorm.ForNoKeyUpdate,
+			dialect:     genorm.MySQL,
+			tableNames:  []string{"messages"},
+			err:         true,
+		},
+		{
+			description: "for key share mysql",
+			lockType:    genorm.ForKeyShare,
+			dialect:     genorm.MySQL,
+			tableNames:  []string{"messages"},
+			err:         true,
+		},
+		{
+			description: "lock of basic table",
+			lockType:    genorm.ForUpdate,
+			dialect:     genorm.MySQL,
+			tableNames:  []string{"messages"},
+			lockTables:  []string{"messages"},
+		},
+		{
+			description: "lock of joined table",
+			lockType:    genorm.ForUpdate,
+			dialect:     genorm.PostgreSQL,
+			joined:      true,
+			tableNames:  []string{"messages", "users"},
+			lockTables:  []string{"users"},
+		},
+		{
+			description: "lock of table not in basic table",
+			lockType:    genorm.ForUpdate,
+			dialect:     genorm.MySQL,
+			tableNames:  []string{"messages"},
+			lockTables:  []string{"users"},
+			err:         true,
+		},
+		{
+			description: "lock of table not in joined table",
+			lockType:    genorm.ForUpdate,
+			dialect:     genorm.MySQL,
+			joined:      true,
+			tableNames:  []string{"messages", "users"},
+			lockTables:  []string{"channels"},
+			err:         true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			newBasicTable := func(tableName string) *mock.MockBasicTable {
+				table := mock.NewMockBasicTable(ctrl)
+				table.
+					EXPECT().
+					TableName().
+					Return(tableName).
+					AnyTimes()
+
+				return table
+			}
+
+			var table genorm.Table
+			if test.joined {
+				baseTables := make([]genorm.BasicTable, 0, len(test.tableNames))
+				for _, tableName := range test.tableNames {
+					baseTables = append(baseTables, newBasicTable(tableName))
+				}
+
+				joinedTable := mock.NewMockJoinedTable(ctrl)
+				joinedTable.
+					EXPECT().
+					BaseTables().
+					Return(baseTables).
+					AnyTimes()
+
+				table = joinedTable
+			} else {
+				table = newBasicTable(test.tableNames[0])
+			}
+
+			var options []genorm.LockOption
+			if len(test.lockTables) != 0 {
+				lockTables := make([]genorm.BasicTable, 0, len(test.lockTables))
+				for _, tableName := range test.lockTables {
+					lockTables = append(lockTables, newBasicTable(tableName))
+				}
+
+				options = append(options, genorm.LockOf(lockTables...))
+			}
+
+			c := genorm.NewLockClause(genorm.LockType(0))
+			err := c.Set(test.lockType, options...)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			err = c.Validate(test.dialect, table)
+			if test.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
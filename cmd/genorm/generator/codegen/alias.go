@@ -0,0 +1,231 @@
+package codegen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+)
+
+var (
+	aliasTypeParamIdent   = ast.NewIdent("A")
+	tablePackageAliasType = ast.NewIdent("Alias")
+	tablePackageAsIdent   = ast.NewIdent("As")
+)
+
+/*
+validateAliasIdent
+name(column or relationship field) must not conflict with
+Alias and As in the table package, and UserAs in the root package.
+*/
+func validateAliasIdent(name string) error {
+	switch name {
+	case tablePackageAliasType.Name, tablePackageAsIdent.Name:
+		return fmt.Errorf("%s conflicts with the generated alias declarations(%s, %s), rename the field", name, tablePackageAliasType.Name, tablePackageAsIdent.Name)
+	}
+
+	return nil
+}
+
+// aliasTypeParams [A genorm.Alias]
+func aliasTypeParams() *ast.FieldList {
+	return &ast.FieldList{
+		List: []*ast.Field{
+			{
+				Names: []*ast.Ident{aliasTypeParamIdent},
+				Type: &ast.SelectorExpr{
+					X:   genormIdent,
+					Sel: ast.NewIdent("Alias"),
+				},
+			},
+		},
+	}
+}
+
+// aliasedTableType genorm.AliasedTable[UserTable, *UserTable, A]
+func aliasedTableType(tableType ast.Expr) ast.Expr {
+	return &ast.IndexListExpr{
+		X: &ast.SelectorExpr{
+			X:   genormIdent,
+			Sel: ast.NewIdent("AliasedTable"),
+		},
+		Indices: []ast.Expr{
+			tableType,
+			&ast.StarExpr{
+				X: tableType,
+			},
+			aliasTypeParamIdent,
+		},
+	}
+}
+
+/*
+aliasFuncDecl
+
+	func UserAs[A genorm.Alias]() *genorm.AliasedTable[UserTable, *UserTable, A] {
+		return genorm.As[A](User())
+	}
+*/
+func (tbl *table) aliasFuncDecl() ast.Decl {
+	return &ast.FuncDecl{
+		Name: tbl.aliasFuncIdent(),
+		Type: &ast.FuncType{
+			TypeParams: aliasTypeParams(),
+			Results: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Type: &ast.StarExpr{
+							X: aliasedTableType(tbl.structIdent),
+						},
+					},
+				},
+			},
+		},
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				&ast.ReturnStmt{
+					Results: []ast.Expr{
+						&ast.CallExpr{
+							Fun: &ast.IndexExpr{
+								X: &ast.SelectorExpr{
+									X:   genormIdent,
+									Sel: ast.NewIdent("As"),
+								},
+								Index: aliasTypeParamIdent,
+							},
+							Args: []ast.Expr{
+								&ast.CallExpr{
+									Fun: tbl.funcIdent,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// aliasFuncIdent UserAs
+func (tbl *table) aliasFuncIdent() *ast.Ident {
+	return ast.NewIdent(tbl.name + tablePackageAsIdent.Name)
+}
+
+/*
+tablePackageAliasDecls
+
+	type Alias[A genorm.Alias] struct {
+		ID     genorm.TypedTableColumns[*genorm.AliasedTable[orm.UserTable, *orm.UserTable, A], genorm.WrappedPrimitive[int64]]
+		IDExpr genorm.TypedTableExpr[*genorm.AliasedTable[orm.UserTable, *orm.UserTable, A], genorm.WrappedPrimitive[int64]]
+	}
+
+	func As[A genorm.Alias]() *Alias[A] {
+		return &Alias[A]{
+			ID:     genorm.AliasColumn[A](ID),
+			IDExpr: genorm.AliasExpr[A](ID),
+		}
+	}
+*/
+func (tbl *table) tablePackageAliasDecls() []ast.Decl {
+	tableType := aliasedTableType(&ast.SelectorExpr{
+		X:   rootPackageIdent,
+		Sel: tbl.structIdent,
+	})
+
+	fields := make([]*ast.Field, 0, len(tbl.columns)*2)
+	values := make([]ast.Expr, 0, len(tbl.columns)*2)
+	for _, column := range tbl.columns {
+		fields = append(
+			fields,
+			&ast.Field{
+				Names: []*ast.Ident{column.tablePackageVarIdent},
+				Type: typedTableColumn(&ast.StarExpr{
+					X: tableType,
+				}, column.fieldType),
+			},
+			&ast.Field{
+				Names: []*ast.Ident{column.tablePackageExprIdent},
+				Type: typedTableExpr(&ast.StarExpr{
+					X: tableType,
+				}, column.fieldType),
+			},
+		)
+
+		values = append(
+			values,
+			&ast.KeyValueExpr{
+				Key:   column.tablePackageVarIdent,
+				Value: aliasColumnCall("AliasColumn", column.tablePackageVarIdent),
+			},
+			&ast.KeyValueExpr{
+				Key:   column.tablePackageExprIdent,
+				Value: aliasColumnCall("AliasExpr", column.tablePackageVarIdent),
+			},
+		)
+	}
+
+	aliasType := &ast.IndexExpr{
+		X:     tablePackageAliasType,
+		Index: aliasTypeParamIdent,
+	}
+
+	return []ast.Decl{
+		&ast.GenDecl{
+			Tok: token.TYPE,
+			Specs: []ast.Spec{
+				&ast.TypeSpec{
+					Name:       tablePackageAliasType,
+					TypeParams: aliasTypeParams(),
+					Type: &ast.StructType{
+						Fields: &ast.FieldList{
+							List: fields,
+						},
+					},
+				},
+			},
+		},
+		&ast.FuncDecl{
+			Name: tablePackageAsIdent,
+			Type: &ast.FuncType{
+				TypeParams: aliasTypeParams(),
+				Results: &ast.FieldList{
+					List: []*ast.Field{
+						{
+							Type: &ast.StarExpr{
+								X: aliasType,
+							},
+						},
+					},
+				},
+			},
+			Body: &ast.BlockStmt{
+				List: []ast.Stmt{
+					&ast.ReturnStmt{
+						Results: []ast.Expr{
+							&ast.UnaryExpr{
+								Op: token.AND,
+								X: &ast.CompositeLit{
+									Type: aliasType,
+									Elts: values,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// aliasColumnCall genorm.AliasColumn[A](ID)
+func aliasColumnCall(funcName string, column *ast.Ident) ast.Expr {
+	return &ast.CallExpr{
+		Fun: &ast.IndexExpr{
+			X: &ast.SelectorExpr{
+				X:   genormIdent,
+				Sel: ast.NewIdent(funcName),
+			},
+			Index: aliasTypeParamIdent,
+		},
+		Args: []ast.Expr{column},
+	}
+}
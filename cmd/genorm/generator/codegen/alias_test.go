@@ -0,0 +1,90 @@
+package codegen
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/mazrean/genorm/cmd/genorm/generator/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertAliasIdents(t *testing.T) {
+	newTable := func(structName string, fieldNames ...string) *types.Table {
+		columns := make([]*types.Column, 0, len(fieldNames))
+		for _, fieldName := range fieldNames {
+			columns = append(columns, &types.Column{
+				Name:      fieldName,
+				FieldName: fieldName,
+				Type:      ast.NewIdent("int64"),
+			})
+		}
+
+		return &types.Table{
+			StructName: structName,
+			Columns:    columns,
+		}
+	}
+
+	tests := []struct {
+		description string
+		tables      func() []*types.Table
+		err         bool
+	}{
+		{
+			description: "normal",
+			tables: func() []*types.Table {
+				return []*types.Table{newTable("User", "ID", "AliasName", "Ask")}
+			},
+		},
+		{
+			description: "column Alias",
+			tables: func() []*types.Table {
+				return []*types.Table{newTable("User", "ID", "Alias")}
+			},
+			err: true,
+		},
+		{
+			description: "column As",
+			tables: func() []*types.Table {
+				return []*types.Table{newTable("User", "ID", "As")}
+			},
+			err: true,
+		},
+		{
+			description: "relationship As",
+			tables: func() []*types.Table {
+				user := newTable("User", "ID", "ManagerID")
+				user.Relationships = []*types.Relationship{
+					{
+						Name:      "As",
+						Column:    user.Columns[1],
+						RefTable:  user,
+						RefColumn: user.Columns[0],
+					},
+				}
+
+				return []*types.Table{user}
+			},
+			err: true,
+		},
+		{
+			description: "table named as the alias constructor",
+			tables: func() []*types.Table {
+				return []*types.Table{newTable("User", "ID"), newTable("UserAs", "ID")}
+			},
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			_, _, err := convert(test.tables(), nil)
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
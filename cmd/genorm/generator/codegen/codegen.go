@@ -188,6 +188,20 @@ func convert(tables []*types.Table, joinedTables []*types.JoinedTable) ([]*table
 		codegenTables = append(codegenTables, codegenTable)
 	}
 
+	for _, table := range codegenTables {
+		// UserAs(the alias constructor of User) is also the constructor of the table UserAs
+		aliasFuncName := table.aliasFuncIdent().Name
+		if _, ok := tableMap[aliasFuncName]; ok {
+			return nil, nil, fmt.Errorf("table(%s) conflicts with the alias constructor of table(%s), rename the struct", aliasFuncName, table.name)
+		}
+
+		// NullableTables is a method of the joined tables, and NullableUser is also the join method to the table NullableUser
+		nullableFuncName := table.nullableFuncIdent().Name
+		if _, ok := tableMap[nullableFuncName]; ok || nullableFuncName == joinedTableNullableTablesIdent.Name {
+			return nil, nil, fmt.Errorf("table(%s) conflicts with the method %s of the joined tables, rename the struct", table.name, nullableFuncName)
+		}
+	}
+
 	joinedTableMap := make(map[string]*joinedTable, len(joinedTables))
 	codegenJoinedTables := make([]*joinedTable, 0, len(joinedTables))
 	for _, joinedTable := range joinedTables {
@@ -217,6 +231,22 @@ func convert(tables []*types.Table, joinedTables []*types.JoinedTable) ([]*table
 			})
 		}
 		codegenTable.refJoinedTables = refJoinedTables
+
+		relationships := make([]*relationship, 0, len(typesTable.Relationships))
+		for _, typesRelationship := range typesTable.Relationships {
+			refTable, ok := tableMap[typesRelationship.RefTable.StructName]
+			if !ok {
+				return nil, nil, fmt.Errorf("ref table(%s) not found", typesRelationship.RefTable.StructName)
+			}
+
+			relationship, err := newRelationship(codegenTable, refTable, typesRelationship)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to create relationship(%s.%s): %w", typesTable.StructName, typesRelationship.Name, err)
+			}
+
+			relationships = append(relationships, relationship)
+		}
+		codegenTable.relationships = relationships
 	}
 
 	for _, typesJoinedTable := range joinedTables {
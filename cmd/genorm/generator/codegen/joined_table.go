@@ -14,6 +14,7 @@ type joinedTable struct {
 	name                     string
 	structIdent              *ast.Ident
 	relationFieldIdent       *ast.Ident
+	nullTablesFieldIdent     *ast.Ident
 	errsFieldIdent           *ast.Ident
 	tablesInterfaceIdent     *ast.Ident
 	recvIdent                *ast.Ident
@@ -22,6 +23,8 @@ type joinedTable struct {
 	columnTypeRecvIdent      *ast.Ident
 	columnParseFuncIdent     *ast.Ident
 	columnParseExprFuncIdent *ast.Ident
+	columnParseNullableIdent *ast.Ident
+	columnParseNullableExpr  *ast.Ident
 	tables                   []*table
 	refTables                []*refTable
 	refJoinedTables          []*refJoinedTable
@@ -37,6 +40,7 @@ func newJoinedTable(jt *types.JoinedTable) *joinedTable {
 		name:                     name,
 		structIdent:              structIdent,
 		relationFieldIdent:       ast.NewIdent("relation"),
+		nullTablesFieldIdent:     ast.NewIdent("nullTables"),
 		errsFieldIdent:           ast.NewIdent("errs"),
 		tablesInterfaceIdent:     ast.NewIdent(name + "Tables"),
 		recvIdent:                ast.NewIdent("jt"),
@@ -45,6 +49,8 @@ func newJoinedTable(jt *types.JoinedTable) *joinedTable {
 		columnTypeRecvIdent:      ast.NewIdent("ct"),
 		columnParseFuncIdent:     ast.NewIdent(name + "Parse"),
 		columnParseExprFuncIdent: ast.NewIdent(name + "ParseExpr"),
+		columnParseNullableIdent: ast.NewIdent(name + "ParseNullable"),
+		columnParseNullableExpr:  ast.NewIdent(name + "ParseNullableExpr"),
 	}
 }
 
@@ -67,14 +73,23 @@ func (jt *joinedTable) decl() []ast.Decl {
 		decls,
 		jt.structDecl(),
 		jt.exprDecl(),
+		jt.indexHintExprDecl(),
 		jt.columnsDecl(),
 		jt.columnMapDecl(),
 		jt.baseTables(),
 		jt.getErrorsDecl(),
 		jt.addErrorDecl(),
 		jt.setRelationDecl(),
+		jt.validateDialectDecl(),
+		jt.nullableTablesDecl(),
+		jt.setNullDecl(),
+		jt.detachDecl(),
 	)
 
+	for _, table := range jt.tables {
+		decls = append(decls, jt.nullableTableDecl(table))
+	}
+
 	for _, ref := range jt.refTables {
 		decls = append(decls, jt.tableJoinDecl(ref))
 	}
@@ -88,6 +103,8 @@ func (jt *joinedTable) decl() []ast.Decl {
 		jt.tablesInterfaceDecl(),
 		jt.columnParseFuncDecl(),
 		jt.columnParseExprFuncDecl(),
+		jt.columnParseNullableFuncDecl(jt.columnParseNullableIdent, typedTableColumn),
+		jt.columnParseNullableFuncDecl(jt.columnParseNullableExpr, typedTableExpr),
 		jt.columnTypeDecl(),
 		jt.columnTypeExprDecl(),
 		jt.columnTypeSQLColumnDecl(),
@@ -101,7 +118,7 @@ func (jt *joinedTable) decl() []ast.Decl {
 }
 
 func (jt *joinedTable) structDecl() ast.Decl {
-	fields := make([]*ast.Field, 0, len(jt.tables)+2)
+	fields := make([]*ast.Field, 0, len(jt.tables)+3)
 	for _, table := range jt.tables {
 		fields = append(fields, &ast.Field{
 			Type: table.structIdent,
@@ -115,6 +132,9 @@ func (jt *joinedTable) structDecl() ast.Decl {
 				Sel: ast.NewIdent("Relation"),
 			},
 		},
+	}, &ast.Field{
+		Names: []*ast.Ident{jt.nullTablesFieldIdent},
+		Type:  nullTablesTypeExpr,
 	}, &ast.Field{
 		Names: []*ast.Ident{jt.errsFieldIdent},
 		Type: &ast.ArrayType{
@@ -189,6 +209,71 @@ func (jt *joinedTable) exprDecl() ast.Decl {
 	}
 }
 
+func (jt *joinedTable) indexHintExprDecl() ast.Decl {
+	hintsIdent := ast.NewIdent("hints")
+
+	return &ast.FuncDecl{
+		Recv: &ast.FieldList{
+			List: []*ast.Field{
+				{
+					Names: []*ast.Ident{jt.recvIdent},
+					Type: &ast.StarExpr{
+						X: jt.structIdent,
+					},
+				},
+			},
+		},
+		Name: joinedTableIndexHintExprIdent,
+		Type: &ast.FuncType{
+			Params: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Names: []*ast.Ident{hintsIdent},
+						Type: &ast.StarExpr{
+							X: indexHintsTypeExpr,
+						},
+					},
+				},
+			},
+			Results: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Type: ast.NewIdent("string"),
+					},
+					{
+						Type: &ast.ArrayType{
+							Elt: exprTypeInterfaceTypeExpr,
+						},
+					},
+					{
+						Type: &ast.ArrayType{
+							Elt: ast.NewIdent("error"),
+						},
+					},
+				},
+			},
+		},
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				&ast.ReturnStmt{
+					Results: []ast.Expr{
+						&ast.CallExpr{
+							Fun: &ast.SelectorExpr{
+								X: &ast.SelectorExpr{
+									X:   jt.recvIdent,
+									Sel: jt.relationFieldIdent,
+								},
+								Sel: joinedTableIndexHintExprIdent,
+							},
+							Args: []ast.Expr{hintsIdent},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func (jt *joinedTable) columnsDecl() ast.Decl {
 	columnExprs := make([]ast.Expr, 0, len(jt.tables))
 	for _, table := range jt.tables {
@@ -543,9 +628,222 @@ func (jt *joinedTable) setRelationDecl() ast.Decl {
 	}
 }
 
+func (jt *joinedTable) validateDialectDecl() ast.Decl {
+	dialectIdent := ast.NewIdent("dialect")
+
+	return &ast.FuncDecl{
+		Recv: &ast.FieldList{
+			List: []*ast.Field{
+				{
+					Names: []*ast.Ident{jt.recvIdent},
+					Type: &ast.StarExpr{
+						X: jt.structIdent,
+					},
+				},
+			},
+		},
+		Name: joinedTableValidateDialectIdent,
+		Type: &ast.FuncType{
+			Params: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Names: []*ast.Ident{dialectIdent},
+						Type:  dialectTypeExpr,
+					},
+				},
+			},
+			Results: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Type: ast.NewIdent("error"),
+					},
+				},
+			},
+		},
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				&ast.ReturnStmt{
+					Results: []ast.Expr{
+						&ast.CallExpr{
+							Fun: &ast.SelectorExpr{
+								X: &ast.SelectorExpr{
+									X:   jt.recvIdent,
+									Sel: jt.relationFieldIdent,
+								},
+								Sel: joinedTableValidateDialectIdent,
+							},
+							Args: []ast.Expr{dialectIdent},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (jt *joinedTable) nullableTablesDecl() ast.Decl {
+	return &ast.FuncDecl{
+		Recv: &ast.FieldList{
+			List: []*ast.Field{
+				{
+					Names: []*ast.Ident{jt.recvIdent},
+					Type: &ast.StarExpr{
+						X: jt.structIdent,
+					},
+				},
+			},
+		},
+		Name: joinedTableNullableTablesIdent,
+		Type: &ast.FuncType{
+			Results: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Type: &ast.ArrayType{
+							Elt: basicTableTypeExpr,
+						},
+					},
+				},
+			},
+		},
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				&ast.ReturnStmt{
+					Results: []ast.Expr{
+						&ast.CallExpr{
+							Fun: &ast.SelectorExpr{
+								X: &ast.SelectorExpr{
+									X:   jt.recvIdent,
+									Sel: jt.relationFieldIdent,
+								},
+								Sel: joinedTableNullableTablesIdent,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (jt *joinedTable) setNullDecl() ast.Decl {
+	tableIdent := ast.NewIdent("table")
+
+	return &ast.FuncDecl{
+		Recv: &ast.FieldList{
+			List: []*ast.Field{
+				{
+					Names: []*ast.Ident{jt.recvIdent},
+					Type: &ast.StarExpr{
+						X: jt.structIdent,
+					},
+				},
+			},
+		},
+		Name: joinedTableSetNullIdent,
+		Type: &ast.FuncType{
+			Params: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Names: []*ast.Ident{tableIdent},
+						Type:  basicTableTypeExpr,
+					},
+				},
+			},
+		},
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				&ast.ExprStmt{
+					X: &ast.CallExpr{
+						Fun: &ast.SelectorExpr{
+							X: &ast.SelectorExpr{
+								X:   jt.recvIdent,
+								Sel: jt.nullTablesFieldIdent,
+							},
+							Sel: joinedTableSetNullIdent,
+						},
+						Args: []ast.Expr{tableIdent},
+					},
+				},
+			},
+		},
+	}
+}
+
+// nullableFuncIdent NullableUser
+func (tbl *table) nullableFuncIdent() *ast.Ident {
+	return ast.NewIdent("Nullable" + tbl.name)
+}
+
+func (jt *joinedTable) nullableTableDecl(table *table) ast.Decl {
+	tableExpr := &ast.UnaryExpr{
+		Op: token.AND,
+		X: &ast.SelectorExpr{
+			X:   jt.recvIdent,
+			Sel: table.structIdent,
+		},
+	}
+
+	return &ast.FuncDecl{
+		Recv: &ast.FieldList{
+			List: []*ast.Field{
+				{
+					Names: []*ast.Ident{jt.recvIdent},
+					Type: &ast.StarExpr{
+						X: jt.structIdent,
+					},
+				},
+			},
+		},
+		Name: table.nullableFuncIdent(),
+		Type: &ast.FuncType{
+			Results: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Type: &ast.StarExpr{
+							X: table.structIdent,
+						},
+					},
+					{
+						Type: ast.NewIdent("bool"),
+					},
+				},
+			},
+		},
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				&ast.ReturnStmt{
+					Results: []ast.Expr{
+						tableExpr,
+						&ast.UnaryExpr{
+							Op: token.NOT,
+							X: &ast.CallExpr{
+								Fun: &ast.SelectorExpr{
+									X: &ast.SelectorExpr{
+										X:   jt.recvIdent,
+										Sel: jt.nullTablesFieldIdent,
+									},
+									Sel: nullTablesIsNullIdent,
+								},
+								Args: []ast.Expr{tableExpr},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func (jt *joinedTable) tableJoinDecl(ref *refTable) ast.Decl {
 	joinIdent := ast.NewIdent(ref.refTable.name)
 	refIdent := ast.NewIdent("ref")
+	fkExpr := foreignKeyExpr(jt.tables, ref.refTable, ref.joinedTable)
+
+	// JoinOnFK is available only if the foreign key is declared
+	resultType := relationContext
+	if fkExpr != nil {
+		resultType = fkRelationContext
+	}
 
 	return &ast.FuncDecl{
 		Recv: &ast.FieldList{
@@ -563,7 +861,7 @@ func (jt *joinedTable) tableJoinDecl(ref *refTable) ast.Decl {
 			Results: &ast.FieldList{
 				List: []*ast.Field{
 					{
-						Type: relationContext(&ast.StarExpr{
+						Type: resultType(&ast.StarExpr{
 							X: jt.structIdent,
 						}, &ast.StarExpr{
 							X: ref.refTable.structIdent,
@@ -588,7 +886,7 @@ func (jt *joinedTable) tableJoinDecl(ref *refTable) ast.Decl {
 				},
 				&ast.ReturnStmt{
 					Results: []ast.Expr{
-						&ast.CallExpr{
+						withForeignKey(&ast.CallExpr{
 							Fun: newRelationContext(&ast.StarExpr{
 								X: jt.structIdent,
 							}, &ast.StarExpr{
@@ -603,7 +901,7 @@ func (jt *joinedTable) tableJoinDecl(ref *refTable) ast.Decl {
 									X:  refIdent,
 								},
 							},
-						},
+						}, fkExpr),
 					},
 				},
 			},
@@ -849,6 +1147,79 @@ func (jt *joinedTable) columnParseExprFuncDecl() ast.Decl {
 	}
 }
 
+/*
+columnParseNullableFuncDecl
+column of the table which can be NULL by outer joins.
+e.g. genorm.NullableColumn(MessageUserParse(column))
+*/
+func (jt *joinedTable) columnParseNullableFuncDecl(name *ast.Ident, resultType func(ast.Expr, ast.Expr) ast.Expr) ast.Decl {
+	tableTypeParamIdent := ast.NewIdent("S")
+	exprTypeParamIdent := ast.NewIdent("T")
+
+	columnParamIdent := ast.NewIdent("column")
+
+	return &ast.FuncDecl{
+		Name: name,
+		Type: &ast.FuncType{
+			TypeParams: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Names: []*ast.Ident{tableTypeParamIdent},
+						Type:  jt.tablesInterfaceIdent,
+					},
+					{
+						Names: []*ast.Ident{exprTypeParamIdent},
+						Type:  exprTypeInterfaceTypeExpr,
+					},
+				},
+			},
+			Params: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Names: []*ast.Ident{columnParamIdent},
+						Type:  typedTableColumn(tableTypeParamIdent, exprTypeParamIdent),
+					},
+				},
+			},
+			Results: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Type: resultType(&ast.StarExpr{
+							X: jt.structIdent,
+						}, &ast.IndexExpr{
+							X: &ast.SelectorExpr{
+								X:   genormIdent,
+								Sel: ast.NewIdent("Nullable"),
+							},
+							Index: exprTypeParamIdent,
+						}),
+					},
+				},
+			},
+		},
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				&ast.ReturnStmt{
+					Results: []ast.Expr{
+						&ast.CallExpr{
+							Fun: &ast.SelectorExpr{
+								X:   genormIdent,
+								Sel: ast.NewIdent("NullableColumn"),
+							},
+							Args: []ast.Expr{
+								&ast.CallExpr{
+									Fun:  jt.columnParseFuncIdent,
+									Args: []ast.Expr{columnParamIdent},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func (jt *joinedTable) columnTypeDecl() ast.Decl {
 	tableTypeParamIdent := ast.NewIdent("S")
 	exprTypeParamIdent := ast.NewIdent("T")
@@ -1184,3 +1555,62 @@ func (jt *joinedTable) columnTypeTypedExprDecl() ast.Decl {
 		},
 	}
 }
+
+func (jt *joinedTable) detachDecl() ast.Decl {
+	targetIdent := ast.NewIdent("target")
+
+	return &ast.FuncDecl{
+		Recv: &ast.FieldList{
+			List: []*ast.Field{
+				{
+					Names: []*ast.Ident{jt.recvIdent},
+					Type: &ast.StarExpr{
+						X: jt.structIdent,
+					},
+				},
+			},
+		},
+		Name: joinedTableDetachIdent,
+		Type: &ast.FuncType{
+			Params: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Names: []*ast.Ident{targetIdent},
+						Type:  basicTableTypeExpr,
+					},
+				},
+			},
+			Results: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Type: tableTypeExpr,
+					},
+					{
+						Type: exprInterfaceTypeExpr,
+					},
+					{
+						Type: ast.NewIdent("error"),
+					},
+				},
+			},
+		},
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				&ast.ReturnStmt{
+					Results: []ast.Expr{
+						&ast.CallExpr{
+							Fun: &ast.SelectorExpr{
+								X: &ast.SelectorExpr{
+									X:   jt.recvIdent,
+									Sel: jt.relationFieldIdent,
+								},
+								Sel: joinedTableDetachIdent,
+							},
+							Args: []ast.Expr{targetIdent},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package codegen
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/mazrean/genorm/cmd/genorm/generator/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertNullableIdents(t *testing.T) {
+	newTable := func(structName string) *types.Table {
+		return &types.Table{
+			StructName: structName,
+			Columns: []*types.Column{
+				{
+					Name:      "id",
+					FieldName: "ID",
+					Type:      ast.NewIdent("int64"),
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		description string
+		tables      []*types.Table
+		err         bool
+	}{
+		{
+			description: "normal",
+			tables:      []*types.Table{newTable("User"), newTable("Nullable")},
+		},
+		{
+			description: "table named as the nullable accessor",
+			tables:      []*types.Table{newTable("User"), newTable("NullableUser")},
+			err:         true,
+		},
+		{
+			description: "table Tables",
+			tables:      []*types.Table{newTable("Tables")},
+			err:         true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			_, _, err := convert(test.tables, nil)
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
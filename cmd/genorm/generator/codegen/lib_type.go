@@ -25,22 +25,45 @@ var (
 		X:   genormIdent,
 		Sel: ast.NewIdent("BasicTable"),
 	}
+	dialectTypeExpr = &ast.SelectorExpr{
+		X:   genormIdent,
+		Sel: ast.NewIdent("Dialect"),
+	}
+	exprInterfaceTypeExpr = &ast.SelectorExpr{
+		X:   genormIdent,
+		Sel: ast.NewIdent("Expr"),
+	}
+	indexHintsTypeExpr = &ast.SelectorExpr{
+		X:   genormIdent,
+		Sel: ast.NewIdent("IndexHints"),
+	}
 	relationTypeExpr = &ast.SelectorExpr{
 		X:   genormRelationIdent,
 		Sel: ast.NewIdent("Relation"),
 	}
+	nullTablesTypeExpr = &ast.SelectorExpr{
+		X:   genormIdent,
+		Sel: ast.NewIdent("NullTables"),
+	}
 
 	exprExprIdent           = ast.NewIdent("Expr")
 	tableExprTableExprIdent = ast.NewIdent("TableExpr")
 	typedExprTypedExprIdent = ast.NewIdent("TypedExpr")
 
-	tableColumnsIdent           = ast.NewIdent("Columns")
-	tableGetErrorsIdent         = ast.NewIdent("GetErrors")
-	tableAddErrorIdent          = ast.NewIdent("AddError")
-	tableColumnMapIdent         = ast.NewIdent("ColumnMap")
-	basicTableTableNameIdent    = ast.NewIdent("TableName")
-	joinedTableBaseTablesIdent  = ast.NewIdent("BaseTables")
-	joinedTableSetRelationIdent = ast.NewIdent("SetRelation")
+	tableColumnsIdent               = ast.NewIdent("Columns")
+	tableGetErrorsIdent             = ast.NewIdent("GetErrors")
+	tableAddErrorIdent              = ast.NewIdent("AddError")
+	tableColumnMapIdent             = ast.NewIdent("ColumnMap")
+	basicTableTableNameIdent        = ast.NewIdent("TableName")
+	joinedTableBaseTablesIdent      = ast.NewIdent("BaseTables")
+	joinedTableSetRelationIdent     = ast.NewIdent("SetRelation")
+	joinedTableValidateDialectIdent = ast.NewIdent("ValidateDialect")
+	joinedTableNullableTablesIdent  = ast.NewIdent("NullableTables")
+	joinedTableDetachIdent          = ast.NewIdent("Detach")
+	joinedTableIndexHintExprIdent   = ast.NewIdent("IndexHintExpr")
+	joinedTableSetNullIdent         = ast.NewIdent("SetNull")
+
+	nullTablesIsNullIdent = ast.NewIdent("IsNull")
 
 	columnSQLColumnsIdent = ast.NewIdent("SQLColumnName")
 	columnTableNameIdent  = ast.NewIdent("TableName")
@@ -86,11 +109,20 @@ func typedTableColumn(tableType ast.Expr, exprType ast.Expr) ast.Expr {
 }
 
 func relationContext(baseTable ast.Expr, refTable ast.Expr, joinedTable ast.Expr) ast.Expr {
+	return relationContextType("RelationContext", baseTable, refTable, joinedTable)
+}
+
+// fkRelationContext *relation.FKRelationContext[...], which has JoinOnFK
+func fkRelationContext(baseTable ast.Expr, refTable ast.Expr, joinedTable ast.Expr) ast.Expr {
+	return relationContextType("FKRelationContext", baseTable, refTable, joinedTable)
+}
+
+func relationContextType(name string, baseTable ast.Expr, refTable ast.Expr, joinedTable ast.Expr) ast.Expr {
 	return &ast.StarExpr{
 		X: &ast.IndexListExpr{
 			X: &ast.SelectorExpr{
 				X:   genormRelationIdent,
-				Sel: ast.NewIdent("RelationContext"),
+				Sel: ast.NewIdent(name),
 			},
 			Indices: []ast.Expr{
 				baseTable,
@@ -0,0 +1,145 @@
+package codegen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/mazrean/genorm/cmd/genorm/generator/types"
+)
+
+type relationship struct {
+	tablePackageVarIdent *ast.Ident
+	column               *column
+	refTable             *table
+	refColumn            *column
+}
+
+func newRelationship(tbl *table, refTbl *table, rel *types.Relationship) (*relationship, error) {
+	err := validateAliasIdent(rel.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	column, ok := tbl.findColumn(rel.Column.Name)
+	if !ok {
+		return nil, fmt.Errorf("column %s not found", rel.Column.Name)
+	}
+
+	refColumn, ok := refTbl.findColumn(rel.RefColumn.Name)
+	if !ok {
+		return nil, fmt.Errorf("ref column %s not found", rel.RefColumn.Name)
+	}
+
+	return &relationship{
+		tablePackageVarIdent: ast.NewIdent(rel.Name),
+		column:               column,
+		refTable:             refTbl,
+		refColumn:            refColumn,
+	}, nil
+}
+
+func (rel *relationship) tablePackageVarDecl() ast.Decl {
+	return &ast.GenDecl{
+		Tok: token.VAR,
+		Specs: []ast.Spec{
+			&ast.ValueSpec{
+				Names: []*ast.Ident{rel.tablePackageVarIdent},
+				Values: []ast.Expr{
+					&ast.CallExpr{
+						Fun: &ast.SelectorExpr{
+							X:   genormIdent,
+							Sel: ast.NewIdent("NewRelationship"),
+						},
+						Args: []ast.Expr{
+							&ast.SelectorExpr{
+								X:   rootPackageIdent,
+								Sel: rel.column.varIdent,
+							},
+							&ast.SelectorExpr{
+								X:   rootPackageIdent,
+								Sel: rel.refColumn.varIdent,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+/*
+foreignKeyExpr
+genorm.Eq(ParseExpr(column), ParseExpr(refColumn)) of the relationship between tables and refTbl.
+nil if there is no relationship or there are multiple relationships.
+*/
+func foreignKeyExpr(tables []*table, refTbl *table, joinedTbl *joinedTable) ast.Expr {
+	type columnPair struct {
+		column    *column
+		refColumn *column
+	}
+
+	pairs := map[[2]*column]columnPair{}
+	for _, tbl := range tables {
+		for _, rel := range tbl.relationships {
+			if rel.refTable == refTbl {
+				pairs[[2]*column{rel.column, rel.refColumn}] = columnPair{
+					column:    rel.column,
+					refColumn: rel.refColumn,
+				}
+			}
+		}
+	}
+
+	for _, rel := range refTbl.relationships {
+		for _, tbl := range tables {
+			if rel.refTable == tbl {
+				pairs[[2]*column{rel.refColumn, rel.column}] = columnPair{
+					column:    rel.refColumn,
+					refColumn: rel.column,
+				}
+			}
+		}
+	}
+
+	if len(pairs) != 1 {
+		return nil
+	}
+
+	var pair columnPair
+	for _, p := range pairs {
+		pair = p
+	}
+
+	return &ast.CallExpr{
+		Fun: &ast.SelectorExpr{
+			X:   genormIdent,
+			Sel: ast.NewIdent("Eq"),
+		},
+		Args: []ast.Expr{
+			&ast.CallExpr{
+				Fun:  joinedTbl.columnParseExprFuncIdent,
+				Args: []ast.Expr{pair.column.varIdent},
+			},
+			&ast.CallExpr{
+				Fun:  joinedTbl.columnParseExprFuncIdent,
+				Args: []ast.Expr{pair.refColumn.varIdent},
+			},
+		},
+	}
+}
+
+// withForeignKey relationContext.WithForeignKey(expr), or relationContext if expr is nil
+func withForeignKey(relationContext ast.Expr, expr ast.Expr) ast.Expr {
+	if expr == nil {
+		return relationContext
+	}
+
+	return &ast.CallExpr{
+		Fun: &ast.SelectorExpr{
+			X:   relationContext,
+			Sel: ast.NewIdent("WithForeignKey"),
+		},
+		Args: []ast.Expr{expr},
+	}
+}
@@ -0,0 +1,95 @@
+package codegen
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"testing"
+
+	"github.com/mazrean/genorm/cmd/genorm/generator/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableJoinDeclForeignKey(t *testing.T) {
+	tests := []struct {
+		description   string
+		relationships []string
+		joinOnFK      bool
+	}{
+		{
+			description: "no foreign key",
+		},
+		{
+			description:   "foreign key",
+			relationships: []string{"UserID"},
+			joinOnFK:      true,
+		},
+		{
+			description:   "two foreign keys",
+			relationships: []string{"UserID", "EditorID"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			newColumn := func(name string) *types.Column {
+				return &types.Column{
+					Name:      name,
+					FieldName: name,
+					Type:      ast.NewIdent("int64"),
+				}
+			}
+
+			user := &types.Table{
+				StructName: "User",
+				Columns:    []*types.Column{newColumn("ID")},
+			}
+			message := &types.Table{
+				StructName: "Message",
+				Columns:    []*types.Column{newColumn("ID"), newColumn("UserID"), newColumn("EditorID")},
+			}
+			joinedTable := &types.JoinedTable{
+				Tables: []*types.Table{message, user},
+			}
+			user.RefTables = []*types.RefTable{{Table: message, JoinedTable: joinedTable}}
+			message.RefTables = []*types.RefTable{{Table: user, JoinedTable: joinedTable}}
+
+			for _, columnName := range test.relationships {
+				for _, column := range message.Columns {
+					if column.Name == columnName {
+						message.Relationships = append(message.Relationships, &types.Relationship{
+							Name:      columnName + "Rel",
+							Column:    column,
+							RefTable:  user,
+							RefColumn: user.Columns[0],
+						})
+					}
+				}
+			}
+
+			tables, _, err := convert([]*types.Table{user, message}, []*types.JoinedTable{joinedTable})
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			// both the table with the relationships and the referenced table
+			for _, tbl := range tables {
+				buf := &bytes.Buffer{}
+				err := format.Node(buf, token.NewFileSet(), tbl.tableJoinDecl(tbl.refTables[0]))
+				if !assert.NoError(t, err) {
+					return
+				}
+
+				decl := buf.String()
+				if test.joinOnFK {
+					assert.Contains(t, decl, "*relation.FKRelationContext[")
+					assert.Contains(t, decl, ".WithForeignKey(genorm.Eq(")
+				} else {
+					assert.Contains(t, decl, "*relation.RelationContext[")
+					assert.NotContains(t, decl, "WithForeignKey")
+				}
+			}
+		})
+	}
+}
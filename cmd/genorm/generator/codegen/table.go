@@ -20,6 +20,7 @@ type table struct {
 	columns         []*column
 	refTables       []*refTable
 	refJoinedTables []*refJoinedTable
+	relationships   []*relationship
 }
 
 func newTable(tbl *types.Table) (*table, error) {
@@ -44,6 +45,11 @@ func newTable(tbl *types.Table) (*table, error) {
 
 	columns := make([]*column, 0, len(tbl.Columns))
 	for _, c := range tbl.Columns {
+		err := validateAliasIdent(c.FieldName)
+		if err != nil {
+			return nil, fmt.Errorf("column(%s): %w", c.Name, err)
+		}
+
 		col := newColumn(codegenTable, c)
 
 		columns = append(columns, col)
@@ -53,6 +59,16 @@ func newTable(tbl *types.Table) (*table, error) {
 	return codegenTable, nil
 }
 
+func (tbl *table) findColumn(columnName string) (*column, bool) {
+	for _, column := range tbl.columns {
+		if column.columnName == columnName {
+			return column, true
+		}
+	}
+
+	return nil, false
+}
+
 func (tbl *table) lowerName() string {
 	return strings.ToLower(tbl.name[0:1]) + tbl.name[1:]
 }
@@ -73,7 +89,7 @@ func (tbl *table) snakeName() string {
 func (tbl *table) decl() []ast.Decl {
 	tableDecls := []ast.Decl{}
 
-	tableDecls = append(tableDecls, tbl.structDecl(), tbl.funcDecl())
+	tableDecls = append(tableDecls, tbl.structDecl(), tbl.funcDecl(), tbl.aliasFuncDecl())
 
 	for _, ref := range tbl.refTables {
 		tableDecls = append(tableDecls, tbl.tableJoinDecl(ref))
@@ -345,6 +361,13 @@ func (tbl *table) getErrorsDecl() ast.Decl {
 func (tbl *table) tableJoinDecl(ref *refTable) ast.Decl {
 	joinIdent := ast.NewIdent(ref.refTable.name)
 	refIdent := ast.NewIdent("ref")
+	fkExpr := foreignKeyExpr([]*table{tbl}, ref.refTable, ref.joinedTable)
+
+	// JoinOnFK is available only if the foreign key is declared
+	resultType := relationContext
+	if fkExpr != nil {
+		resultType = fkRelationContext
+	}
 
 	return &ast.FuncDecl{
 		Recv: &ast.FieldList{
@@ -362,7 +385,7 @@ func (tbl *table) tableJoinDecl(ref *refTable) ast.Decl {
 			Results: &ast.FieldList{
 				List: []*ast.Field{
 					{
-						Type: relationContext(&ast.StarExpr{
+						Type: resultType(&ast.StarExpr{
 							X: tbl.structIdent,
 						}, &ast.StarExpr{
 							X: ref.refTable.structIdent,
@@ -387,7 +410,7 @@ func (tbl *table) tableJoinDecl(ref *refTable) ast.Decl {
 				},
 				&ast.ReturnStmt{
 					Results: []ast.Expr{
-						&ast.CallExpr{
+						withForeignKey(&ast.CallExpr{
 							Fun: newRelationContext(&ast.StarExpr{
 								X: tbl.structIdent,
 							}, &ast.StarExpr{
@@ -402,7 +425,7 @@ func (tbl *table) tableJoinDecl(ref *refTable) ast.Decl {
 									X:  refIdent,
 								},
 							},
-						},
+						}, fkExpr),
 					},
 				},
 			},
@@ -481,6 +504,12 @@ func (tbl *table) tablePackageDecls() []ast.Decl {
 		decls = append(decls, column.tablePackageDecls()...)
 	}
 
+	for _, relationship := range tbl.relationships {
+		decls = append(decls, relationship.tablePackageVarDecl())
+	}
+
+	decls = append(decls, tbl.tablePackageAliasDecls()...)
+
 	return decls
 }
 
@@ -3,19 +3,34 @@ package convert
 import (
 	"errors"
 	"fmt"
+	"go/token"
 	"math"
 	"sort"
 
 	"github.com/mazrean/genorm/cmd/genorm/generator/types"
 )
 
-func Convert(tables []*types.Table, joinNum int) ([]*types.Table, []*types.JoinedTable, error) {
-	tables, joinedTables, err := convertJoinedTables(tables, joinNum)
+/*
+Convert
+joinSets: sets of the struct names of the tables joined together.
+If joinSets is not empty, only the joined tables of the subsets of joinSets are generated.
+fset: file set of the source, used for the positions in the errors and the warnings.
+The warnings report the joins which are not generated.
+*/
+func Convert(fset *token.FileSet, tables []*types.Table, joinNum int, joinSets [][]string) ([]*types.Table, []*types.JoinedTable, []string, error) {
+	warnings := selfRefTableWarnings(fset, tables)
+
+	tables, joinedTables, err := convertJoinedTables(tables, joinNum, joinSets)
 	if err != nil {
-		return nil, nil, fmt.Errorf("generate joined tables: %w", err)
+		return nil, nil, nil, fmt.Errorf("generate joined tables: %w", err)
 	}
 
-	return tables, joinedTables, nil
+	err = validateJoinedTables(fset, joinedTables)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("validate joined tables: %w", err)
+	}
+
+	return tables, joinedTables, warnings, nil
 }
 
 type converterTable struct {
@@ -81,15 +96,83 @@ func (cjt *converterJoinedTable) tablesHash(tableNum int) int64 {
 	return joinedTableHash
 }
 
-func convertJoinedTables(tables []*types.Table, joinNum int) ([]*types.Table, []*types.JoinedTable, error) {
+// joinedTableFilter filter of the joined tables to generate
+type joinedTableFilter struct {
+	// joinSets nil if all joined tables are generated
+	joinSets []map[int]struct{}
+}
+
+func newJoinedTableFilter(tables []*converterTable, joinNum int, joinSets [][]string) (*joinedTableFilter, error) {
+	if len(joinSets) == 0 {
+		return &joinedTableFilter{}, nil
+	}
+
+	tableIDMap := make(map[string]int, len(tables))
+	for _, table := range tables {
+		tableIDMap[table.table.StructName] = table.id
+	}
+
+	filterJoinSets := make([]map[int]struct{}, 0, len(joinSets))
+	for _, joinSet := range joinSets {
+		filterJoinSet := make(map[int]struct{}, len(joinSet))
+		for _, structName := range joinSet {
+			tableID, ok := tableIDMap[structName]
+			if !ok {
+				return nil, fmt.Errorf("table in join set not found: %s", structName)
+			}
+
+			filterJoinSet[tableID] = struct{}{}
+		}
+
+		if len(filterJoinSet) < 2 {
+			return nil, fmt.Errorf("join set must have at least 2 tables: %v", joinSet)
+		}
+		if len(filterJoinSet) > joinNum {
+			return nil, fmt.Errorf("join set has more tables than join num(%d): %v", joinNum, joinSet)
+		}
+
+		filterJoinSets = append(filterJoinSets, filterJoinSet)
+	}
+
+	return &joinedTableFilter{
+		joinSets: filterJoinSets,
+	}, nil
+}
+
+// allow true if tables are a subset of a join set
+func (f *joinedTableFilter) allow(tables map[int]*converterTable) bool {
+	if f.joinSets == nil {
+		return true
+	}
+
+JOIN_SET_LOOP:
+	for _, joinSet := range f.joinSets {
+		for tableID := range tables {
+			if _, ok := joinSet[tableID]; !ok {
+				continue JOIN_SET_LOOP
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+func convertJoinedTables(tables []*types.Table, joinNum int, joinSets [][]string) ([]*types.Table, []*types.JoinedTable, error) {
 	converterTables, err := tablesToConverterTables(tables, joinNum)
 	if err != nil {
 		return nil, nil, fmt.Errorf("create generate tables: %w", err)
 	}
 
-	converterTables, generateJoinedTableMap := createJoinedTables(converterTables, joinNum)
-	converterTables, generateJoinedTableMap = setTablesRefJoinedTable(converterTables, generateJoinedTableMap, joinNum)
-	generateJoinedTableMap = setJoinedTablesRefJoinedTable(generateJoinedTableMap, len(tables), joinNum)
+	filter, err := newJoinedTableFilter(converterTables, joinNum, joinSets)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create joined table filter: %w", err)
+	}
+
+	converterTables, generateJoinedTableMap := createJoinedTables(converterTables, joinNum, filter)
+	converterTables, generateJoinedTableMap = setTablesRefJoinedTable(converterTables, generateJoinedTableMap, joinNum, filter)
+	generateJoinedTableMap = setJoinedTablesRefJoinedTable(generateJoinedTableMap, len(tables), joinNum, filter)
 
 	tables, joinedTables, err := converterTableToTable(converterTables, generateJoinedTableMap)
 	if err != nil {
@@ -122,6 +205,11 @@ func tablesToConverterTables(tables []*types.Table, joinNum int) ([]*converterTa
 				return nil, fmt.Errorf("ref table not found: %s", refTable.Table.StructName)
 			}
 
+			// the columns of a self-joined table conflict in the column map(reported by selfRefTableWarnings)
+			if refConverterTable == converterTableValue {
+				continue
+			}
+
 			converterTableValue.refTables[refConverterTable.id] = &converterRefTable{
 				refTable: refConverterTable,
 			}
@@ -143,7 +231,7 @@ func tablesToConverterTables(tables []*types.Table, joinNum int) ([]*converterTa
 	return converterTables, nil
 }
 
-func createJoinedTables(tables []*converterTable, joinNum int) ([]*converterTable, map[int64]*converterJoinedTable) {
+func createJoinedTables(tables []*converterTable, joinNum int, filter *joinedTableFilter) ([]*converterTable, map[int64]*converterJoinedTable) {
 	joinedTableHashMap := make(map[int64]*converterJoinedTable)
 	for _, table := range tables {
 		for _, joinedTable := range table.joinTablesList[0] {
@@ -171,6 +259,9 @@ func createJoinedTables(tables []*converterTable, joinNum int) ([]*converterTabl
 					for _, table := range refJoinedTable.tables {
 						joinTables[table.id] = table
 					}
+					if !filter.allow(joinTables) {
+						continue
+					}
 
 					joinedTableRefs := make(map[int]*converterRefTable, len(refJoinedTable.refTables)+len(table.refTables)-2)
 					for _, refTable := range refJoinedTable.refTables {
@@ -224,6 +315,9 @@ func createJoinedTables(tables []*converterTable, joinNum int) ([]*converterTabl
 					for _, table := range refJoinedTable.tables {
 						joinTables[table.id] = table
 					}
+					if !filter.allow(joinTables) {
+						continue
+					}
 
 					joinedTableRefs := make(map[int]*converterRefTable, len(refJoinedTable.refTables)+len(table.refTables)-2)
 					for _, refTable := range refJoinedTable.refTables {
@@ -270,7 +364,7 @@ func createJoinedTables(tables []*converterTable, joinNum int) ([]*converterTabl
 	return tables, joinedTableHashMap
 }
 
-func setTablesRefJoinedTable(tables []*converterTable, joinedTableMap map[int64]*converterJoinedTable, joinNum int) ([]*converterTable, map[int64]*converterJoinedTable) {
+func setTablesRefJoinedTable(tables []*converterTable, joinedTableMap map[int64]*converterJoinedTable, joinNum int, filter *joinedTableFilter) ([]*converterTable, map[int64]*converterJoinedTable) {
 	for _, table := range tables {
 		refJoinedTables := map[int64]*converterRefJoinedTable{}
 		for _, refTable := range table.refTables {
@@ -285,6 +379,9 @@ func setTablesRefJoinedTable(tables []*converterTable, joinedTableMap map[int64]
 					for _, table := range joinedTable.tables {
 						joinTables[table.id] = table
 					}
+					if !filter.allow(joinTables) {
+						continue
+					}
 
 					var joinedTableRefs map[int]*converterRefTable
 					if len(joinTables) == joinNum {
@@ -348,7 +445,7 @@ func setTablesRefJoinedTable(tables []*converterTable, joinedTableMap map[int64]
 	return tables, joinedTableMap
 }
 
-func setJoinedTablesRefJoinedTable(joinedTableMap map[int64]*converterJoinedTable, tableNum int, joinNum int) map[int64]*converterJoinedTable {
+func setJoinedTablesRefJoinedTable(joinedTableMap map[int64]*converterJoinedTable, tableNum int, joinNum int, filter *joinedTableFilter) map[int64]*converterJoinedTable {
 	for _, table := range joinedTableMap {
 		refJoinedTables := map[int64]*converterRefJoinedTable{}
 		for _, refTable := range table.refTables {
@@ -368,6 +465,9 @@ func setJoinedTablesRefJoinedTable(joinedTableMap map[int64]*converterJoinedTabl
 					for _, table := range joinedTable.tables {
 						joinTables[table.id] = table
 					}
+					if !filter.allow(joinTables) {
+						continue
+					}
 
 					newJoinedTable := newConverterJoinedTable(joinTables, nil)
 					newJoinedTableHash := newJoinedTable.tablesHash(tableNum)
@@ -407,9 +507,11 @@ func converterTableToTable(converterTables []*converterTable, generateJoinedTabl
 	tableMap := make(map[int]*types.Table, len(converterTables))
 	for _, converterTable := range converterTables {
 		tableMap[converterTable.id] = &types.Table{
-			StructName: converterTable.table.StructName,
-			Columns:    converterTable.table.Columns,
-			Methods:    converterTable.table.Methods,
+			StructName:    converterTable.table.StructName,
+			Pos:           converterTable.table.Pos,
+			Columns:       converterTable.table.Columns,
+			Methods:       converterTable.table.Methods,
+			Relationships: converterTable.table.Relationships,
 		}
 	}
 
@@ -438,6 +540,11 @@ func converterTableToTable(converterTables []*converterTable, generateJoinedTabl
 
 			refTables := make([]*types.RefTable, 0, len(generateJoinedTable.refTables))
 			for _, refTable := range generateJoinedTable.refTables {
+				// not generated by the join sets
+				if refTable.joinedTable == nil {
+					continue
+				}
+
 				refTables = append(refTables, &types.RefTable{
 					Table:       tableMap[refTable.refTable.id],
 					JoinedTable: joinedTableMap[refTable.joinedTable.tablesHash(len(converterTables))],
@@ -463,6 +570,11 @@ func converterTableToTable(converterTables []*converterTable, generateJoinedTabl
 
 		refTables := make([]*types.RefTable, 0, len(generateJoinedTable.refTables))
 		for _, refTable := range generateJoinedTable.refTables {
+			// not generated by the join sets
+			if refTable.joinedTable == nil {
+				continue
+			}
+
 			refTables = append(refTables, &types.RefTable{
 				Table:       tableMap[refTable.refTable.id],
 				JoinedTable: joinedTableMap[refTable.joinedTable.tablesHash(len(converterTables))],
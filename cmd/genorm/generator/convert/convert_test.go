@@ -2,6 +2,7 @@ package convert
 
 import (
 	"go/ast"
+	"go/token"
 	"sort"
 	"testing"
 
@@ -212,12 +213,121 @@ func TestConvertJoinedTables(t *testing.T) {
 		},
 	}
 
+	newColumns := func() []*types.Column {
+		return []*types.Column{
+			{
+				Name:      "id",
+				FieldName: "ID",
+				Type:      typeIdent1,
+			},
+		}
+	}
+
+	messageOptionTable3 := &types.Table{
+		StructName:      "MessageOption",
+		Columns:         newColumns(),
+		Methods:         []*types.Method{},
+		RefTables:       []*types.RefTable{},
+		RefJoinedTables: []*types.RefJoinedTable{},
+	}
+	messageTable3 := &types.Table{
+		StructName:      "Message",
+		Columns:         newColumns(),
+		Methods:         []*types.Method{},
+		RefTables:       []*types.RefTable{},
+		RefJoinedTables: []*types.RefJoinedTable{},
+	}
+	userTable3 := &types.Table{
+		StructName:      "User",
+		Columns:         newColumns(),
+		Methods:         []*types.Method{},
+		RefJoinedTables: []*types.RefJoinedTable{},
+	}
+	userMessageJoinedTable3 := &types.JoinedTable{
+		Tables:          []*types.Table{messageTable3, userTable3},
+		RefTables:       []*types.RefTable{},
+		RefJoinedTables: []*types.RefJoinedTable{},
+	}
+	userTable3.RefTables = []*types.RefTable{
+		{
+			Table:       messageTable3,
+			JoinedTable: userMessageJoinedTable3,
+		},
+	}
+
+	newJoinSetTables := func() []*types.Table {
+		messageOption := &types.Table{
+			StructName: "MessageOption",
+			Columns:    newColumns(),
+			Methods:    []*types.Method{},
+		}
+		message := &types.Table{
+			StructName: "Message",
+			Columns:    newColumns(),
+			Methods:    []*types.Method{},
+			RefTables: []*types.RefTable{
+				{
+					Table: messageOption,
+				},
+			},
+		}
+		user := &types.Table{
+			StructName: "User",
+			Columns:    newColumns(),
+			Methods:    []*types.Method{},
+			RefTables: []*types.RefTable{
+				{
+					Table: message,
+				},
+			},
+		}
+
+		return []*types.Table{user, message, messageOption}
+	}
+
+	// users.manager_id = users.id
+	selfRefTable := &types.Table{
+		StructName: "User",
+		Columns: []*types.Column{
+			{
+				Name:      "id",
+				FieldName: "ID",
+				Type:      typeIdent1,
+			},
+			{
+				Name:      "manager_id",
+				FieldName: "ManagerID",
+				Type:      typeIdent1,
+			},
+		},
+		Methods: []*types.Method{
+			{
+				Type: types.MethodTypeIdentifier,
+				Decl: funcDecl,
+			},
+		},
+	}
+	selfRefTable.RefTables = []*types.RefTable{
+		{
+			Table: selfRefTable,
+		},
+	}
+	selfRefTable.Relationships = []*types.Relationship{
+		{
+			Name:      "Manager",
+			Column:    selfRefTable.Columns[1],
+			RefTable:  selfRefTable,
+			RefColumn: selfRefTable.Columns[0],
+		},
+	}
+
 	tests := []struct {
 		description        string
 		tables             []*types.Table
 		expectTables       []*types.Table
 		expectJoinedTables []*types.JoinedTable
 		joinNum            int
+		joinSets           [][]string
 		err                bool
 	}{
 		{
@@ -381,17 +491,86 @@ func TestConvertJoinedTables(t *testing.T) {
 				userMessageMessageOptionTable2,
 			},
 		},
+		{
+			description: "self reference",
+			joinNum:     5,
+			tables: []*types.Table{
+				selfRefTable,
+			},
+			expectTables: []*types.Table{
+				{
+					StructName: "User",
+					Columns: []*types.Column{
+						{
+							Name:      "id",
+							FieldName: "ID",
+							Type:      typeIdent1,
+						},
+						{
+							Name:      "manager_id",
+							FieldName: "ManagerID",
+							Type:      typeIdent1,
+						},
+					},
+					Methods: []*types.Method{
+						{
+							Type: types.MethodTypeIdentifier,
+							Decl: funcDecl,
+						},
+					},
+					Relationships:   selfRefTable.Relationships,
+					RefTables:       []*types.RefTable{},
+					RefJoinedTables: []*types.RefJoinedTable{},
+				},
+			},
+			expectJoinedTables: []*types.JoinedTable{},
+		},
+		{
+			description: "join sets",
+			joinNum:     5,
+			joinSets:    [][]string{{"User", "Message"}},
+			tables:      newJoinSetTables(),
+			expectTables: []*types.Table{
+				userTable3,
+				messageTable3,
+				messageOptionTable3,
+			},
+			expectJoinedTables: []*types.JoinedTable{userMessageJoinedTable3},
+		},
+		{
+			description: "join set with unknown table",
+			joinNum:     5,
+			joinSets:    [][]string{{"User", "Channel"}},
+			tables:      newJoinSetTables(),
+			err:         true,
+		},
+		{
+			description: "join set with one table",
+			joinNum:     5,
+			joinSets:    [][]string{{"User", "User"}},
+			tables:      newJoinSetTables(),
+			err:         true,
+		},
+		{
+			description: "join set larger than join num",
+			joinNum:     2,
+			joinSets:    [][]string{{"User", "Message", "MessageOption"}},
+			tables:      newJoinSetTables(),
+			err:         true,
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
-			tables, joinedTables, err := convertJoinedTables(test.tables, test.joinNum)
-			if err != nil {
+			tables, joinedTables, err := convertJoinedTables(test.tables, test.joinNum, test.joinSets)
+			if test.err {
 				assert.Error(t, err)
 				return
 			}
 
-			assert.NoError(t, err)
+			if !assert.NoError(t, err) {
+				return
+			}
 
 			for _, table := range tables {
 				sort.Slice(table.RefTables, func(i, j int) bool {
@@ -409,3 +588,67 @@ func TestConvertJoinedTables(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertSelfReference(t *testing.T) {
+	t.Parallel()
+
+	fset := token.NewFileSet()
+	f := fset.AddFile("schema.go", -1, 100)
+	f.SetLines([]int{0, 20, 40, 60, 80})
+
+	// users.manager_id = users.id
+	user := &types.Table{
+		StructName: "User",
+		Pos:        f.Pos(5),
+		Columns: []*types.Column{
+			{
+				Name:      "id",
+				FieldName: "ID",
+				Type:      ast.NewIdent("int64"),
+			},
+		},
+	}
+	message := &types.Table{
+		StructName: "Message",
+		Pos:        f.Pos(45),
+		Columns: []*types.Column{
+			{
+				Name:      "id",
+				FieldName: "ID",
+				Type:      ast.NewIdent("int64"),
+			},
+		},
+	}
+	user.RefTables = []*types.RefTable{
+		{
+			Table: user,
+			Pos:   f.Pos(25),
+		},
+	}
+	message.RefTables = []*types.RefTable{
+		{
+			Table: user,
+			Pos:   f.Pos(65),
+		},
+	}
+
+	_, joinedTables, warnings, err := Convert(fset, []*types.Table{user, message}, 5, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []string{
+		"User(schema.go:2:6) references itself, and the self-joined table is not generated(column map keys conflict, join it by relation.Pair and genorm.As)",
+	}, warnings)
+
+	// only the joined table of Message and User is generated
+	if !assert.Len(t, joinedTables, 1) {
+		return
+	}
+
+	structNames := []string{}
+	for _, table := range joinedTables[0].Tables {
+		structNames = append(structNames, table.StructName)
+	}
+	assert.ElementsMatch(t, []string{"User", "Message"}, structNames)
+}
@@ -0,0 +1,143 @@
+package convert
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"sort"
+	"strconv"
+
+	"github.com/mazrean/genorm/cmd/genorm/generator/types"
+)
+
+/*
+validateJoinedTables
+the column map of a joined table is keyed by "table.column",
+so the base tables of a joined table must have different table names.
+*/
+func validateJoinedTables(fset *token.FileSet, joinedTables []*types.JoinedTable) error {
+	type conflict struct {
+		tableName string
+		tables    [2]*types.Table
+	}
+
+	conflicts := []*conflict{}
+	for _, joinedTable := range joinedTables {
+		tableMap := make(map[string]*types.Table, len(joinedTable.Tables))
+		for _, table := range joinedTable.Tables {
+			name, ok := tableName(table)
+			if !ok {
+				continue
+			}
+
+			conflictTable, ok := tableMap[name]
+			if !ok {
+				tableMap[name] = table
+				continue
+			}
+
+			conflictTables := [2]*types.Table{conflictTable, table}
+			sort.Slice(conflictTables[:], func(i, j int) bool {
+				return conflictTables[i].Pos < conflictTables[j].Pos
+			})
+
+			conflicts = append(conflicts, &conflict{
+				tableName: name,
+				tables:    conflictTables,
+			})
+		}
+	}
+
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		if conflicts[i].tables[0].Pos != conflicts[j].tables[0].Pos {
+			return conflicts[i].tables[0].Pos < conflicts[j].tables[0].Pos
+		}
+
+		return conflicts[i].tables[1].Pos < conflicts[j].tables[1].Pos
+	})
+
+	first := conflicts[0]
+	return fmt.Errorf(
+		"%s(%s) and %s(%s) are joined but have the same table name %s(column map keys conflict, exclude the join by -join-sets or use genorm.As)",
+		first.tables[0].StructName, fset.Position(first.tables[0].Pos),
+		first.tables[1].StructName, fset.Position(first.tables[1].Pos),
+		first.tableName,
+	)
+}
+
+/*
+selfRefTableWarnings
+the joined table of a table and itself is not generated because the columns of the base tables conflict in the column map,
+so the self-referencing genorm.Ref fields are reported to be joined by relation.Pair and genorm.As.
+*/
+func selfRefTableWarnings(fset *token.FileSet, tables []*types.Table) []string {
+	type selfRef struct {
+		structName string
+		pos        token.Pos
+	}
+
+	selfRefs := []*selfRef{}
+	for _, table := range tables {
+		for _, refTable := range table.RefTables {
+			if refTable.Table.StructName != table.StructName {
+				continue
+			}
+
+			selfRefs = append(selfRefs, &selfRef{
+				structName: table.StructName,
+				pos:        refTable.Pos,
+			})
+		}
+	}
+
+	sort.Slice(selfRefs, func(i, j int) bool {
+		return selfRefs[i].pos < selfRefs[j].pos
+	})
+
+	warnings := make([]string, 0, len(selfRefs))
+	for _, ref := range selfRefs {
+		warnings = append(warnings, fmt.Sprintf(
+			"%s(%s) references itself, and the self-joined table is not generated(column map keys conflict, join it by relation.Pair and genorm.As)",
+			ref.structName, fset.Position(ref.pos),
+		))
+	}
+
+	return warnings
+}
+
+// tableName table name returned by the TableName method(false if it is not a string literal)
+func tableName(table *types.Table) (string, bool) {
+	for _, method := range table.Methods {
+		decl := method.Decl
+		if decl == nil || decl.Name == nil || decl.Name.Name != "TableName" {
+			continue
+		}
+
+		if decl.Body == nil || len(decl.Body.List) != 1 {
+			return "", false
+		}
+
+		returnStmt, ok := decl.Body.List[0].(*ast.ReturnStmt)
+		if !ok || len(returnStmt.Results) != 1 {
+			return "", false
+		}
+
+		lit, ok := returnStmt.Results[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return "", false
+		}
+
+		name, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return "", false
+		}
+
+		return name, true
+	}
+
+	return "", false
+}
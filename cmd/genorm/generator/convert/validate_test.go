@@ -0,0 +1,95 @@
+package convert
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/mazrean/genorm/cmd/genorm/generator/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateJoinedTables(t *testing.T) {
+	t.Parallel()
+
+	fset := token.NewFileSet()
+	f := fset.AddFile("schema.go", -1, 100)
+	f.SetLines([]int{0, 20, 40, 60, 80})
+
+	newTable := func(structName string, offset int, tableName string) *types.Table {
+		return &types.Table{
+			StructName: structName,
+			Pos:        f.Pos(offset),
+			Methods: []*types.Method{
+				{
+					Type: types.MethodTypeStar,
+					Decl: parseFuncDecl(t, `func (*T) TableName() string { return "`+tableName+`" }`),
+				},
+			},
+		}
+	}
+
+	userTable := newTable("User", 5, "users")
+	messageTable := newTable("Message", 25, "messages")
+	adminTable := newTable("Admin", 45, "users")
+	channelTable := newTable("Channel", 65, "channels")
+	channelTable.Methods[0].Decl = parseFuncDecl(t, `func (*T) TableName() string { return prefix + "channels" }`)
+
+	tests := []struct {
+		description  string
+		joinedTables []*types.JoinedTable
+		err          string
+	}{
+		{
+			description: "different table names",
+			joinedTables: []*types.JoinedTable{
+				{Tables: []*types.Table{userTable, messageTable}},
+			},
+		},
+		{
+			description: "same table name",
+			joinedTables: []*types.JoinedTable{
+				{Tables: []*types.Table{userTable, messageTable}},
+				{Tables: []*types.Table{adminTable, messageTable, userTable}},
+			},
+			err: "User(schema.go:1:6) and Admin(schema.go:3:6) are joined but have the same table name users(column map keys conflict, exclude the join by -join-sets or use genorm.As)",
+		},
+		{
+			description: "same table name not joined",
+			joinedTables: []*types.JoinedTable{
+				{Tables: []*types.Table{userTable, messageTable}},
+				{Tables: []*types.Table{adminTable, messageTable}},
+			},
+		},
+		{
+			description: "table name not literal",
+			joinedTables: []*types.JoinedTable{
+				{Tables: []*types.Table{userTable, channelTable}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			err := validateJoinedTables(fset, test.joinedTables)
+			if len(test.err) != 0 {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func parseFuncDecl(t *testing.T, src string) *ast.FuncDecl {
+	t.Helper()
+
+	f, err := parser.ParseFile(token.NewFileSet(), "", "package orm\n"+src, parser.Mode(0))
+	if err != nil {
+		t.Fatalf("failed to parse func decl: %s", err)
+	}
+
+	return f.Decls[0].(*ast.FuncDecl)
+}
@@ -13,6 +13,10 @@ import (
 
 type Config struct {
 	JoinNum int
+	// JoinSets sets of the struct names of the tables joined together(nil: all sets up to JoinNum tables)
+	JoinSets [][]string
+	// Warning writer of the warnings, e.g. the joins not generated(nil: discarded)
+	Warning io.Writer
 }
 
 func Generate(packageName string, moduleName string, destinationDir string, src io.Reader, config Config) error {
@@ -27,11 +31,20 @@ func Generate(packageName string, moduleName string, destinationDir string, src
 		return fmt.Errorf("parse: %w", err)
 	}
 
-	tables, joinedTables, err := convert.Convert(parserTables, config.JoinNum)
+	tables, joinedTables, warnings, err := convert.Convert(fset, parserTables, config.JoinNum, config.JoinSets)
 	if err != nil {
 		return fmt.Errorf("convert: %w", err)
 	}
 
+	if config.Warning != nil {
+		for _, warning := range warnings {
+			_, err = fmt.Fprintf(config.Warning, "warning: %s\n", warning)
+			if err != nil {
+				return fmt.Errorf("write warning: %w", err)
+			}
+		}
+	}
+
 	err = codegen.Codegen(packageName, moduleName, destinationDir, f, tables, joinedTables)
 	if err != nil {
 		return fmt.Errorf("codegen: %w", err)
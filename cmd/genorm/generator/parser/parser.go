@@ -1,16 +1,21 @@
 package parser
 
 import (
+	"errors"
 	"fmt"
 	"go/ast"
+	"go/token"
+	gotypes "go/types"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/mazrean/genorm/cmd/genorm/generator/types"
 )
 
 type parserTable struct {
 	StructName string
+	Pos        token.Pos
 	Columns    []*parserColumn
 	Methods    []*parserMethod
 	RefTables  []*parserRefTable
@@ -25,6 +30,10 @@ type parserMethod struct {
 type parserRefTable struct {
 	FieldName  string
 	StructName string
+	Pos        token.Pos
+	// ForeignKey, Reference column names of the foreign key(genorm:"fk=foreign_key,ref=reference")
+	ForeignKey string
+	Reference  string
 }
 
 type parserColumn struct {
@@ -99,7 +108,19 @@ func convertTables(tables []*parserTable) ([]*types.Table, error) {
 
 			refTables = append(refTables, &types.RefTable{
 				Table: refTable.converted,
+				Pos:   refParserTable.Pos,
 			})
+
+			if len(refParserTable.ForeignKey) == 0 {
+				continue
+			}
+
+			relationship, err := convertRelationship(refParserTable, pair.converted, refTable.converted)
+			if err != nil {
+				return nil, fmt.Errorf("relationship(%s.%s): %w", pair.parser.StructName, refParserTable.FieldName, err)
+			}
+
+			pair.converted.Relationships = append(pair.converted.Relationships, relationship)
 		}
 
 		pair.converted.RefTables = refTables
@@ -110,6 +131,58 @@ func convertTables(tables []*parserTable) ([]*types.Table, error) {
 	return convertedTables, nil
 }
 
+/*
+convertRelationship
+belongs-to: table.foreign_key = ref_table.reference
+has-many: table.reference = ref_table.foreign_key
+self reference: belongs-to
+*/
+func convertRelationship(refParserTable *parserRefTable, table *types.Table, refTable *types.Table) (*types.Relationship, error) {
+	findColumn := func(table *types.Table, columnName string) *types.Column {
+		for _, column := range table.Columns {
+			if column.Name == columnName {
+				return column
+			}
+		}
+
+		return nil
+	}
+
+	belongsToColumn := findColumn(table, refParserTable.ForeignKey)
+	belongsToRefColumn := findColumn(refTable, refParserTable.Reference)
+	hasManyColumn := findColumn(table, refParserTable.Reference)
+	hasManyRefColumn := findColumn(refTable, refParserTable.ForeignKey)
+
+	isBelongsTo := belongsToColumn != nil && belongsToRefColumn != nil
+	isHasMany := hasManyColumn != nil && hasManyRefColumn != nil
+
+	var column, refColumn *types.Column
+	switch {
+	case isBelongsTo && table == refTable:
+		// self reference(e.g. users.manager_id = users.id) is belongs-to
+		column, refColumn = belongsToColumn, belongsToRefColumn
+	case isBelongsTo && isHasMany:
+		return nil, fmt.Errorf("ambiguous foreign key %s: both %s and %s have it", refParserTable.ForeignKey, table.StructName, refTable.StructName)
+	case isBelongsTo:
+		column, refColumn = belongsToColumn, belongsToRefColumn
+	case isHasMany:
+		column, refColumn = hasManyColumn, hasManyRefColumn
+	default:
+		return nil, fmt.Errorf("foreign key %s referencing %s not found in %s and %s", refParserTable.ForeignKey, refParserTable.Reference, table.StructName, refTable.StructName)
+	}
+
+	if gotypes.ExprString(column.Type) != gotypes.ExprString(refColumn.Type) {
+		return nil, fmt.Errorf("type mismatch: %s, %s", gotypes.ExprString(column.Type), gotypes.ExprString(refColumn.Type))
+	}
+
+	return &types.Relationship{
+		Name:      refParserTable.FieldName,
+		Column:    column,
+		RefTable:  refTable,
+		RefColumn: refColumn,
+	}, nil
+}
+
 func convertTable(table *parserTable) *types.Table {
 	columns := make([]*types.Column, 0, len(table.Columns))
 	for _, column := range table.Columns {
@@ -130,6 +203,7 @@ func convertTable(table *parserTable) *types.Table {
 
 	return &types.Table{
 		StructName: table.StructName,
+		Pos:        table.Pos,
 		Columns:    columns,
 		Methods:    methods,
 	}
@@ -192,6 +266,7 @@ func parseGenDecl(g *ast.GenDecl) ([]*parserTable, error) {
 		}
 
 		if table != nil {
+			table.Pos = typeSpec.Name.Pos()
 			tables = append(tables, table)
 		}
 	}
@@ -213,7 +288,17 @@ func parseStructType(name string, s *ast.StructType) (*parserTable, error) {
 	columns := []*parserColumn{}
 	refTables := []*parserRefTable{}
 	for _, field := range fields {
+		tag, err := parseTag(field.Tag)
+		if err != nil {
+			return nil, err
+		}
+
 		if tableName, isRef := checkRefType(field.Type); isRef {
+			foreignKey, reference, err := parseRefTag(tag)
+			if err != nil {
+				return nil, fmt.Errorf("ref tag(%s): %w", tag, err)
+			}
+
 			for _, name := range field.Names {
 				if name == nil {
 					continue
@@ -222,25 +307,15 @@ func parseStructType(name string, s *ast.StructType) (*parserTable, error) {
 				refTables = append(refTables, &parserRefTable{
 					StructName: tableName,
 					FieldName:  name.Name,
+					Pos:        name.Pos(),
+					ForeignKey: foreignKey,
+					Reference:  reference,
 				})
 			}
 
 			continue
 		}
 
-		tagLit := field.Tag
-
-		var tag string
-		if tagLit != nil {
-			tagValue, err := strconv.Unquote(tagLit.Value)
-			if err != nil {
-				return nil, fmt.Errorf("unquote tag: %w", err)
-			}
-
-			structTag := reflect.StructTag(tagValue)
-			tag = structTag.Get("genorm")
-		}
-
 		for _, name := range field.Names {
 			var columnName string
 			if len(tag) != 0 {
@@ -264,6 +339,50 @@ func parseStructType(name string, s *ast.StructType) (*parserTable, error) {
 	}, nil
 }
 
+// parseTag value of the genorm tag
+func parseTag(tagLit *ast.BasicLit) (string, error) {
+	if tagLit == nil {
+		return "", nil
+	}
+
+	tagValue, err := strconv.Unquote(tagLit.Value)
+	if err != nil {
+		return "", fmt.Errorf("unquote tag: %w", err)
+	}
+
+	return reflect.StructTag(tagValue).Get("genorm"), nil
+}
+
+// parseRefTag "fk=foreign_key,ref=reference"
+func parseRefTag(tag string) (string, string, error) {
+	if len(tag) == 0 {
+		return "", "", nil
+	}
+
+	var foreignKey, reference string
+	for _, option := range strings.Split(tag, ",") {
+		key, value, ok := strings.Cut(option, "=")
+		if !ok || len(value) == 0 {
+			return "", "", fmt.Errorf("invalid option: %s", option)
+		}
+
+		switch key {
+		case "fk":
+			foreignKey = value
+		case "ref":
+			reference = value
+		default:
+			return "", "", fmt.Errorf("unknown option: %s", key)
+		}
+	}
+
+	if len(foreignKey) == 0 || len(reference) == 0 {
+		return "", "", errors.New("both fk and ref are required")
+	}
+
+	return foreignKey, reference, nil
+}
+
 func checkRefType(t ast.Expr) (string, bool) {
 	indexExpr, ok := t.(*ast.IndexExpr)
 	if !ok || indexExpr == nil {
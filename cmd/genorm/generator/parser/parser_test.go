@@ -164,6 +164,161 @@ func TestConvertTables(t *testing.T) {
 	}
 }
 
+func TestConvertRelationship(t *testing.T) {
+	t.Parallel()
+
+	userIDColumn := &types.Column{
+		Name:      "id",
+		FieldName: "ID",
+		Type:      ast.NewIdent("int64"),
+	}
+	userTable := &types.Table{
+		StructName: "User",
+		Columns:    []*types.Column{userIDColumn},
+	}
+
+	messageUserIDColumn := &types.Column{
+		Name:      "user_id",
+		FieldName: "UserID",
+		Type:      ast.NewIdent("int64"),
+	}
+	messageContentColumn := &types.Column{
+		Name:      "content",
+		FieldName: "Content",
+		Type:      ast.NewIdent("string"),
+	}
+	messageTable := &types.Table{
+		StructName: "Message",
+		Columns:    []*types.Column{messageUserIDColumn, messageContentColumn},
+	}
+
+	managerIDColumn := &types.Column{
+		Name:      "manager_id",
+		FieldName: "ManagerID",
+		Type:      ast.NewIdent("int64"),
+	}
+	managerTable := &types.Table{
+		StructName: "User",
+		Columns:    []*types.Column{userIDColumn, managerIDColumn},
+	}
+
+	tests := []struct {
+		description    string
+		parserRefTable *parserRefTable
+		table          *types.Table
+		refTable       *types.Table
+		relationship   *types.Relationship
+		err            bool
+	}{
+		{
+			description: "has many",
+			parserRefTable: &parserRefTable{
+				FieldName:  "Messages",
+				StructName: "Message",
+				ForeignKey: "user_id",
+				Reference:  "id",
+			},
+			table:    userTable,
+			refTable: messageTable,
+			relationship: &types.Relationship{
+				Name:      "Messages",
+				Column:    userIDColumn,
+				RefTable:  messageTable,
+				RefColumn: messageUserIDColumn,
+			},
+		},
+		{
+			description: "belongs to",
+			parserRefTable: &parserRefTable{
+				FieldName:  "User",
+				StructName: "User",
+				ForeignKey: "user_id",
+				Reference:  "id",
+			},
+			table:    messageTable,
+			refTable: userTable,
+			relationship: &types.Relationship{
+				Name:      "User",
+				Column:    messageUserIDColumn,
+				RefTable:  userTable,
+				RefColumn: userIDColumn,
+			},
+		},
+		{
+			description: "foreign key not found",
+			parserRefTable: &parserRefTable{
+				FieldName:  "Messages",
+				StructName: "Message",
+				ForeignKey: "owner_id",
+				Reference:  "id",
+			},
+			table:    userTable,
+			refTable: messageTable,
+			err:      true,
+		},
+		{
+			description: "self reference",
+			parserRefTable: &parserRefTable{
+				FieldName:  "Manager",
+				StructName: "User",
+				ForeignKey: "manager_id",
+				Reference:  "id",
+			},
+			table:    managerTable,
+			refTable: managerTable,
+			relationship: &types.Relationship{
+				Name:      "Manager",
+				Column:    managerIDColumn,
+				RefTable:  managerTable,
+				RefColumn: userIDColumn,
+			},
+		},
+		{
+			description: "ambiguous",
+			parserRefTable: &parserRefTable{
+				FieldName:  "Users",
+				StructName: "User",
+				ForeignKey: "manager_id",
+				Reference:  "id",
+			},
+			table: managerTable,
+			refTable: &types.Table{
+				StructName: "Owner",
+				Columns:    []*types.Column{userIDColumn, managerIDColumn},
+			},
+			err: true,
+		},
+		{
+			description: "type mismatch",
+			parserRefTable: &parserRefTable{
+				FieldName:  "Messages",
+				StructName: "Message",
+				ForeignKey: "content",
+				Reference:  "id",
+			},
+			table:    userTable,
+			refTable: messageTable,
+			err:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			relationship, err := convertRelationship(test.parserRefTable, test.table, test.refTable)
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.relationship, relationship)
+		})
+	}
+}
+
 func TestParseFuncDecl(t *testing.T) {
 	t.Parallel()
 
@@ -573,6 +728,80 @@ func TestParseStructType(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "struct type(ref with tag exist) -> success",
+			name:        "a",
+			s: &ast.StructType{
+				Fields: &ast.FieldList{
+					List: []*ast.Field{
+						{
+							Names: []*ast.Ident{
+								ast.NewIdent("s"),
+							},
+							Type: refType,
+							Tag: &ast.BasicLit{
+								Kind:  token.STRING,
+								Value: "`genorm:\"fk=a_id,ref=id\"`",
+							},
+						},
+					},
+				},
+			},
+			table: &parserTable{
+				StructName: "a",
+				Columns:    []*parserColumn{},
+				RefTables: []*parserRefTable{
+					{
+						FieldName:  "s",
+						StructName: "Table",
+						ForeignKey: "a_id",
+						Reference:  "id",
+					},
+				},
+			},
+		},
+		{
+			description: "struct type(ref with invalid tag exist) -> error",
+			name:        "a",
+			s: &ast.StructType{
+				Fields: &ast.FieldList{
+					List: []*ast.Field{
+						{
+							Names: []*ast.Ident{
+								ast.NewIdent("s"),
+							},
+							Type: refType,
+							Tag: &ast.BasicLit{
+								Kind:  token.STRING,
+								Value: "`genorm:\"fk=a_id\"`",
+							},
+						},
+					},
+				},
+			},
+			err: true,
+		},
+		{
+			description: "struct type(ref with unknown tag option exist) -> error",
+			name:        "a",
+			s: &ast.StructType{
+				Fields: &ast.FieldList{
+					List: []*ast.Field{
+						{
+							Names: []*ast.Ident{
+								ast.NewIdent("s"),
+							},
+							Type: refType,
+							Tag: &ast.BasicLit{
+								Kind:  token.STRING,
+								Value: "`genorm:\"id=a_id\"`",
+							},
+						},
+					},
+				},
+			},
+			err: true,
+		},
 		{
 			description: "struct type(multi column) -> success",
 			name:        "a",
@@ -732,6 +961,14 @@ func TestParseStructType(t *testing.T) {
 				if refTable.StructName != test.table.RefTables[j].StructName {
 					t.Fatalf("ref table struct name is not match(expected: %s, actual: %s)", test.table.RefTables[j].StructName, refTable.StructName)
 				}
+
+				if refTable.ForeignKey != test.table.RefTables[j].ForeignKey {
+					t.Fatalf("ref table foreign key is not match(expected: %s, actual: %s)", test.table.RefTables[j].ForeignKey, refTable.ForeignKey)
+				}
+
+				if refTable.Reference != test.table.RefTables[j].Reference {
+					t.Fatalf("ref table reference is not match(expected: %s, actual: %s)", test.table.RefTables[j].Reference, refTable.Reference)
+				}
 			}
 		})
 	}
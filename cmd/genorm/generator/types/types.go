@@ -2,14 +2,18 @@ package types
 
 import (
 	"go/ast"
+	"go/token"
 )
 
 type Table struct {
-	StructName      string
+	StructName string
+	// Pos position of the struct name in the source
+	Pos             token.Pos
 	Columns         []*Column
 	Methods         []*Method
 	RefTables       []*RefTable
 	RefJoinedTables []*RefJoinedTable
+	Relationships   []*Relationship
 }
 
 type Method struct {
@@ -33,6 +37,16 @@ const (
 type RefTable struct {
 	Table       *Table
 	JoinedTable *JoinedTable
+	// Pos position of the genorm.Ref field in the source
+	Pos token.Pos
+}
+
+// Relationship Table.Column = RefTable.RefColumn
+type Relationship struct {
+	Name      string
+	Column    *Column
+	RefTable  *Table
+	RefColumn *Column
 }
 
 type RefJoinedTable struct {
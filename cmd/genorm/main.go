@@ -1,12 +1,14 @@
 package main
 
 import (
+	"bufio"
 	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"os"
 	"runtime/debug"
+	"strings"
 
 	"github.com/mazrean/genorm/cmd/genorm/generator"
 )
@@ -19,6 +21,7 @@ var (
 	packageName     string
 	moduleName      string
 	joinNum         int
+	joinSetsPath    string
 )
 
 func init() {
@@ -28,6 +31,7 @@ func init() {
 	flag.StringVar(&packageName, "package", "", "The root package name to use.")
 	flag.StringVar(&moduleName, "module", "", "The root module name to use.")
 	flag.IntVar(&joinNum, "join-num", 5, "The number of joins to generate.")
+	flag.StringVar(&joinSetsPath, "join-sets", "", "The file of the table sets to generate joined tables for. If empty, joined tables for all table sets are generated.")
 }
 
 func main() {
@@ -57,8 +61,15 @@ func main() {
 		panic("module name is required")
 	}
 
+	joinSets, err := readJoinSets(joinSetsPath)
+	if err != nil {
+		panic(err)
+	}
+
 	err = generator.Generate(packageName, moduleName, destination, src, generator.Config{
-		JoinNum: joinNum,
+		JoinNum:  joinNum,
+		JoinSets: joinSets,
+		Warning:  os.Stderr,
 	})
 	if err != nil {
 		panic(err)
@@ -91,3 +102,61 @@ func openSource(source string) (io.ReadCloser, error) {
 
 	return file, nil
 }
+
+func readJoinSets(path string) ([][]string, error) {
+	if len(path) == 0 {
+		return nil, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open join sets: %w", err)
+	}
+	defer file.Close()
+
+	joinSets, err := parseJoinSets(file)
+	if err != nil {
+		return nil, fmt.Errorf("parse join sets: %w", err)
+	}
+
+	return joinSets, nil
+}
+
+/*
+parseJoinSets
+one join set per line, struct names separated by commas.
+e.g.
+
+	# comment
+	User, Message
+	User, Message, Channel
+*/
+func parseJoinSets(r io.Reader) ([][]string, error) {
+	joinSets := [][]string{}
+
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		structNames := strings.Split(line, ",")
+		joinSet := make([]string, 0, len(structNames))
+		for _, structName := range structNames {
+			structName = strings.TrimSpace(structName)
+			if len(structName) == 0 {
+				return nil, fmt.Errorf("line %d: empty struct name", lineNum)
+			}
+
+			joinSet = append(joinSet, structName)
+		}
+
+		joinSets = append(joinSets, joinSet)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read join sets: %w", err)
+	}
+
+	return joinSets, nil
+}
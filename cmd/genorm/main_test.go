@@ -4,6 +4,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -130,3 +131,57 @@ func main() {
 		})
 	}
 }
+
+func TestParseJoinSets(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		content     string
+		joinSets    [][]string
+		err         bool
+	}{
+		{
+			description: "join sets -> success",
+			content: `# comment
+User, Message
+
+User,Message,Channel
+`,
+			joinSets: [][]string{
+				{"User", "Message"},
+				{"User", "Message", "Channel"},
+			},
+		},
+		{
+			description: "empty -> success",
+			content:     "",
+			joinSets:    [][]string{},
+		},
+		{
+			description: "empty struct name -> error",
+			content:     "User,,Message\n",
+			err:         true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			joinSets, err := parseJoinSets(strings.NewReader(test.content))
+			if err != nil {
+				if !test.err {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if test.err {
+				t.Fatalf("expected error but got none")
+			}
+
+			if !reflect.DeepEqual(joinSets, test.joinSets) {
+				t.Fatalf("unexpected join sets: %v", joinSets)
+			}
+		})
+	}
+}
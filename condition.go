@@ -0,0 +1,58 @@
+package genorm
+
+// Conditions accumulator of conditions joined with AND.
+// nil or empty conditions are ignored, so filters can be added one at a time.
+// Conditions are rendered when the query is built, so conditions added after Where/OrWhere are included.
+// Where/OrWhere with Conditions that are still empty when the query is built is no-op.
+type Conditions[T Table] struct {
+	conditions []TypedTableExpr[T, WrappedPrimitive[bool]]
+}
+
+func NewConditions[T Table](conditions ...TypedTableExpr[T, WrappedPrimitive[bool]]) *Conditions[T] {
+	return (&Conditions[T]{}).Add(conditions...)
+}
+
+func (c *Conditions[T]) Add(conditions ...TypedTableExpr[T, WrappedPrimitive[bool]]) *Conditions[T] {
+	for _, condition := range conditions {
+		if condition == nil {
+			continue
+		}
+
+		if ec, ok := condition.(emptiableCondition); ok && ec.isEmpty() {
+			continue
+		}
+
+		c.conditions = append(c.conditions, condition)
+	}
+
+	return c
+}
+
+func (c *Conditions[_]) Len() int {
+	return len(c.conditions)
+}
+
+func (c *Conditions[_]) isEmpty() bool {
+	return c == nil || len(c.conditions) == 0
+}
+
+// Expr (condition1 AND condition2 AND ...). TRUE if empty.
+func (c *Conditions[T]) Expr() (string, []ExprType, []error) {
+	if c == nil {
+		return AndAll[T]().Expr()
+	}
+
+	return AndAll(c.conditions...).Expr()
+}
+
+func (c *Conditions[T]) TableExpr(T) (string, []ExprType, []error) {
+	return c.Expr()
+}
+
+func (c *Conditions[_]) TypedExpr(WrappedPrimitive[bool]) (string, []ExprType, []error) {
+	return c.Expr()
+}
+
+type emptiableCondition interface {
+	isEmpty() bool
+}
@@ -0,0 +1,183 @@
+package genorm_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mazrean/genorm"
+	"github.com/mazrean/genorm/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConditions(t *testing.T) {
+	t.Parallel()
+
+	type condition struct {
+		isNil   bool
+		isEmpty bool
+		query   string
+		args    []genorm.ExprType
+		errs    []error
+	}
+
+	tests := []struct {
+		description   string
+		conditions    []condition
+		expectedLen   int
+		expectedQuery string
+		expectedArgs  []genorm.ExprType
+		isError       bool
+	}{
+		{
+			description:   "empty",
+			expectedLen:   0,
+			expectedQuery: "TRUE",
+		},
+		{
+			description: "single",
+			conditions: []condition{
+				{query: "(hoge.huga = ?)", args: []genorm.ExprType{genorm.Wrap(1)}},
+			},
+			expectedLen:   1,
+			expectedQuery: "(hoge.huga = ?)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(1)},
+		},
+		{
+			description: "multiple",
+			conditions: []condition{
+				{query: "(hoge.huga = ?)", args: []genorm.ExprType{genorm.Wrap(1)}},
+				{query: "(hoge.piyo = ?)", args: []genorm.ExprType{genorm.Wrap(2)}},
+				{query: "(hoge.fuga = ?)", args: []genorm.ExprType{genorm.Wrap(3)}},
+			},
+			expectedLen:   3,
+			expectedQuery: "((hoge.huga = ?) AND (hoge.piyo = ?) AND (hoge.fuga = ?))",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(2), genorm.Wrap(3)},
+		},
+		{
+			description: "nil and empty conditions are ignored",
+			conditions: []condition{
+				{isNil: true},
+				{query: "(hoge.huga = ?)", args: []genorm.ExprType{genorm.Wrap(1)}},
+				{isEmpty: true},
+				{query: "(hoge.piyo = ?)", args: []genorm.ExprType{genorm.Wrap(2)}},
+			},
+			expectedLen:   2,
+			expectedQuery: "((hoge.huga = ?) AND (hoge.piyo = ?))",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(2)},
+		},
+		{
+			description: "only nil conditions",
+			conditions: []condition{
+				{isNil: true},
+				{isEmpty: true},
+			},
+			expectedLen:   0,
+			expectedQuery: "TRUE",
+		},
+		{
+			description: "condition error",
+			conditions: []condition{
+				{query: "(hoge.huga = ?)", args: []genorm.ExprType{genorm.Wrap(1)}},
+				{errs: []error{errors.New("condition error")}},
+			},
+			expectedLen: 2,
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			conditions := genorm.NewConditions[*mock.MockTable]()
+			for _, condition := range test.conditions {
+				switch {
+				case condition.isNil:
+					conditions = conditions.Add(nil)
+				case condition.isEmpty:
+					conditions = conditions.Add(genorm.NewConditions[*mock.MockTable]())
+				default:
+					mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+					mockExpr.
+						EXPECT().
+						Expr().
+						Return(condition.query, condition.args, condition.errs).
+						AnyTimes()
+
+					conditions = conditions.Add(mockExpr)
+				}
+			}
+
+			assert.Equal(t, test.expectedLen, conditions.Len())
+
+			var expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]] = conditions
+
+			query, args, errs := expr.Expr()
+			if test.isError {
+				assert.NotEmpty(t, errs)
+				return
+			}
+
+			if !assert.Nil(t, errs) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
+
+func TestWhereConditionClauseEmptyConditions(t *testing.T) {
+	t.Parallel()
+
+	c := genorm.NewWhereConditionClause[*mock.MockTable](nil)
+
+	err := c.Set(genorm.NewConditions[*mock.MockTable]())
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	err = c.Or(genorm.NewConditions[*mock.MockTable]())
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.False(t, c.Exists())
+}
+
+func TestWhereConditionClauseConditionsAddedAfterSet(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+	mockExpr.
+		EXPECT().
+		Expr().
+		Return("(hoge.huga = ?)", []genorm.ExprType{genorm.Wrap(1)}, nil).
+		AnyTimes()
+
+	c := genorm.NewWhereConditionClause[*mock.MockTable](nil)
+	conditions := genorm.NewConditions[*mock.MockTable]()
+
+	err := c.Set(conditions)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	conditions.Add(mockExpr)
+
+	if !assert.True(t, c.Exists()) {
+		return
+	}
+
+	query, args, err := c.GetExpr()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "(hoge.huga = ?)", query)
+	assert.Equal(t, []genorm.ExprType{genorm.Wrap(1)}, args)
+}
@@ -1,14 +1,29 @@
 package genorm
 
+import (
+	"fmt"
+	"slices"
+)
+
 type Context[T Table] struct {
-	table T
-	errs  []error
+	table   T
+	dialect Dialect
+	errs    []error
 }
 
 func newContext[T Table](table T) *Context[T] {
 	return &Context[T]{
-		table: table,
-		errs:  table.GetErrors(),
+		table:   table,
+		dialect: MySQL,
+		errs:    table.GetErrors(),
+	}
+}
+
+func (c *Context[T]) clone() *Context[T] {
+	return &Context[T]{
+		table:   c.table,
+		dialect: c.dialect,
+		errs:    slices.Clone(c.errs),
 	}
 }
 
@@ -16,6 +31,38 @@ func (c *Context[T]) Table() T {
 	return c.table
 }
 
+func (c *Context[T]) setDialect(dialect Dialect) {
+	err := dialect.validate()
+	if err != nil {
+		c.addError(fmt.Errorf("dialect: %w", err))
+		return
+	}
+
+	c.dialect = dialect
+}
+
+// tableExpr expression of the table, checked that the table is supported in the dialect
+func (c *Context[T]) tableExpr() (string, []ExprType, error) {
+	return c.indexHintTableExpr(nil)
+}
+
+// indexHintTableExpr the table expression with the index hints of hints
+func (c *Context[T]) indexHintTableExpr(hints *IndexHints) (string, []ExprType, error) {
+	if dv, ok := any(c.table).(DialectValidator); ok {
+		err := dv.ValidateDialect(c.dialect)
+		if err != nil {
+			return "", nil, fmt.Errorf("validate dialect: %w", err)
+		}
+	}
+
+	tableQuery, tableArgs, errs := hints.TableExpr(c.table)
+	if len(errs) != 0 {
+		return "", nil, errs[0]
+	}
+
+	return tableQuery, tableArgs, nil
+}
+
 func (c *Context[T]) addError(err error) {
 	c.errs = append(c.errs, err)
 }
@@ -27,3 +74,13 @@ func (c *Context[T]) Errors() []error {
 
 	return c.errs
 }
+
+// resolveDialectExprs render the dialect-dependent expressions in the query with the dialect of the builder
+func (c *Context[T]) resolveDialectExprs(query string, args []ExprType) (string, []ExprType, error) {
+	query, args, err := resolveDialectExprs(c.dialect, query, args)
+	if err != nil {
+		return "", nil, fmt.Errorf("resolve dialect expressions: %w", err)
+	}
+
+	return query, args, nil
+}
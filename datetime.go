@@ -0,0 +1,313 @@
+package genorm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Date and Time Functions
+// DateTrunc, Extract, DateAdd, DateSub and TimestampDiff are rendered with the dialect of the query builder.
+
+// TimeUnit unit of date and time functions
+type TimeUnit uint8
+
+const (
+	Year TimeUnit = iota + 1
+	Month
+	Day
+	Hour
+	Minute
+	Second
+)
+
+func (tu TimeUnit) keyword() (string, error) {
+	switch tu {
+	case Year:
+		return "YEAR", nil
+	case Month:
+		return "MONTH", nil
+	case Day:
+		return "DAY", nil
+	case Hour:
+		return "HOUR", nil
+	case Minute:
+		return "MINUTE", nil
+	case Second:
+		return "SECOND", nil
+	}
+
+	return "", fmt.Errorf("invalid time unit: %d", tu)
+}
+
+// Interval interval literal(e.g. Interval{Value: 1, Unit: Day} is 1 day)
+type Interval struct {
+	Value int64
+	Unit  TimeUnit
+}
+
+// Now NOW()
+func Now[T Table]() TypedTableExpr[T, WrappedPrimitive[time.Time]] {
+	return &ExprStruct[T, WrappedPrimitive[time.Time]]{
+		query: "NOW()",
+	}
+}
+
+// Date CAST(expr AS DATE)
+func Date[T Table](expr TypedTableExpr[T, WrappedPrimitive[time.Time]]) TypedTableExpr[T, WrappedPrimitive[time.Time]] {
+	if expr == nil {
+		return &ExprStruct[T, WrappedPrimitive[time.Time]]{
+			errs: []error{errors.New("date expr is nil")},
+		}
+	}
+
+	query, args, errs := expr.Expr()
+	if len(errs) != 0 {
+		return &ExprStruct[T, WrappedPrimitive[time.Time]]{
+			errs: errs,
+		}
+	}
+
+	return &ExprStruct[T, WrappedPrimitive[time.Time]]{
+		query: fmt.Sprintf("CAST(%s AS DATE)", query),
+		args:  args,
+	}
+}
+
+// mysqlDateTruncFormats DATE_FORMAT formats used to emulate DATE_TRUNC in MySQL
+var mysqlDateTruncFormats = map[TimeUnit]string{
+	Year:   "%Y-01-01 00:00:00",
+	Month:  "%Y-%m-01 00:00:00",
+	Day:    "%Y-%m-%d 00:00:00",
+	Hour:   "%Y-%m-%d %H:00:00",
+	Minute: "%Y-%m-%d %H:%i:00",
+	Second: "%Y-%m-%d %H:%i:%s",
+}
+
+/*
+	DateTrunc
+	MySQL: STR_TO_DATE(DATE_FORMAT(expr, format), '%Y-%m-%d %H:%i:%s')
+	PostgreSQL: DATE_TRUNC('unit', expr)
+*/
+func DateTrunc[T Table](unit TimeUnit, expr TypedTableExpr[T, WrappedPrimitive[time.Time]]) TypedTableExpr[T, WrappedPrimitive[time.Time]] {
+	if expr == nil {
+		return &ExprStruct[T, WrappedPrimitive[time.Time]]{
+			errs: []error{errors.New("date trunc expr is nil")},
+		}
+	}
+
+	unitQuery, err := unit.keyword()
+	if err != nil {
+		return &ExprStruct[T, WrappedPrimitive[time.Time]]{
+			errs: []error{fmt.Errorf("date trunc: %w", err)},
+		}
+	}
+
+	query, args, errs := expr.Expr()
+	if len(errs) != 0 {
+		return &ExprStruct[T, WrappedPrimitive[time.Time]]{
+			errs: errs,
+		}
+	}
+
+	return newDialectExpr[T, WrappedPrimitive[time.Time]](func(dialect Dialect) (string, []ExprType, error) {
+		switch dialect {
+		case MySQL:
+			return fmt.Sprintf(
+				"STR_TO_DATE(DATE_FORMAT(%s, '%s'), '%%Y-%%m-%%d %%H:%%i:%%s')",
+				query, mysqlDateTruncFormats[unit],
+			), args, nil
+		case PostgreSQL:
+			return fmt.Sprintf("DATE_TRUNC('%s', %s)", unitQuery, query), args, nil
+		}
+
+		return "", nil, fmt.Errorf("date trunc: %w", dialect.validate())
+	})
+}
+
+/*
+	Extract
+	MySQL: EXTRACT(unit FROM expr)
+	PostgreSQL: CAST(EXTRACT(unit FROM expr) AS BIGINT)
+*/
+func Extract[T Table](unit TimeUnit, expr TypedTableExpr[T, WrappedPrimitive[time.Time]]) TypedTableExpr[T, WrappedPrimitive[int64]] {
+	if expr == nil {
+		return &ExprStruct[T, WrappedPrimitive[int64]]{
+			errs: []error{errors.New("extract expr is nil")},
+		}
+	}
+
+	unitQuery, err := unit.keyword()
+	if err != nil {
+		return &ExprStruct[T, WrappedPrimitive[int64]]{
+			errs: []error{fmt.Errorf("extract: %w", err)},
+		}
+	}
+
+	query, args, errs := expr.Expr()
+	if len(errs) != 0 {
+		return &ExprStruct[T, WrappedPrimitive[int64]]{
+			errs: errs,
+		}
+	}
+
+	return newDialectExpr[T, WrappedPrimitive[int64]](func(dialect Dialect) (string, []ExprType, error) {
+		switch dialect {
+		case MySQL:
+			return fmt.Sprintf("EXTRACT(%s FROM %s)", unitQuery, query), args, nil
+		case PostgreSQL:
+			// PostgreSQL returns numeric, which can have a fractional part for SECOND
+			return fmt.Sprintf("CAST(EXTRACT(%s FROM %s) AS BIGINT)", unitQuery, query), args, nil
+		}
+
+		return "", nil, fmt.Errorf("extract: %w", dialect.validate())
+	})
+}
+
+/*
+	DateAdd
+	MySQL: DATE_ADD(expr, INTERVAL ? unit)
+	PostgreSQL: (expr + ? * INTERVAL '1 unit')
+*/
+func DateAdd[T Table](expr TypedTableExpr[T, WrappedPrimitive[time.Time]], interval Interval) TypedTableExpr[T, WrappedPrimitive[time.Time]] {
+	return dateArithmetic("DATE_ADD", "+", expr, interval)
+}
+
+/*
+	DateSub
+	MySQL: DATE_SUB(expr, INTERVAL ? unit)
+	PostgreSQL: (expr - ? * INTERVAL '1 unit')
+*/
+func DateSub[T Table](expr TypedTableExpr[T, WrappedPrimitive[time.Time]], interval Interval) TypedTableExpr[T, WrappedPrimitive[time.Time]] {
+	return dateArithmetic("DATE_SUB", "-", expr, interval)
+}
+
+func dateArithmetic[T Table](
+	mysqlFuncName string,
+	postgresOperator string,
+	expr TypedTableExpr[T, WrappedPrimitive[time.Time]],
+	interval Interval,
+) TypedTableExpr[T, WrappedPrimitive[time.Time]] {
+	if expr == nil {
+		return &ExprStruct[T, WrappedPrimitive[time.Time]]{
+			errs: []error{fmt.Errorf("%s expr is nil", strings.ToLower(mysqlFuncName))},
+		}
+	}
+
+	unitQuery, err := interval.Unit.keyword()
+	if err != nil {
+		return &ExprStruct[T, WrappedPrimitive[time.Time]]{
+			errs: []error{fmt.Errorf("interval: %w", err)},
+		}
+	}
+
+	query, args, errs := expr.Expr()
+	if len(errs) != 0 {
+		return &ExprStruct[T, WrappedPrimitive[time.Time]]{
+			errs: errs,
+		}
+	}
+
+	newArgs := make([]ExprType, 0, len(args)+1)
+	newArgs = append(newArgs, args...)
+	newArgs = append(newArgs, Wrap(interval.Value))
+
+	return newDialectExpr[T, WrappedPrimitive[time.Time]](func(dialect Dialect) (string, []ExprType, error) {
+		switch dialect {
+		case MySQL:
+			return fmt.Sprintf("%s(%s, INTERVAL ? %s)", mysqlFuncName, query, unitQuery), newArgs, nil
+		case PostgreSQL:
+			return fmt.Sprintf("(%s %s ? * INTERVAL '1 %s')", query, postgresOperator, unitQuery), newArgs, nil
+		}
+
+		return "", nil, fmt.Errorf("%s: %w", strings.ToLower(mysqlFuncName), dialect.validate())
+	})
+}
+
+// postgresSecondsPerUnit seconds per unit used to emulate TIMESTAMPDIFF in PostgreSQL
+var postgresSecondsPerUnit = map[TimeUnit]int64{
+	Day:    24 * 60 * 60,
+	Hour:   60 * 60,
+	Minute: 60,
+	Second: 1,
+}
+
+/*
+	TimestampDiff number of whole units from start to end
+	MySQL: TIMESTAMPDIFF(unit, start, end)
+	PostgreSQL: CAST(TRUNC(EXTRACT(EPOCH FROM (end - start)) / seconds) AS BIGINT)
+		or the YEAR/MONTH fields of AGE(end, start)
+*/
+func TimestampDiff[T Table](
+	unit TimeUnit,
+	start TypedTableExpr[T, WrappedPrimitive[time.Time]],
+	end TypedTableExpr[T, WrappedPrimitive[time.Time]],
+) TypedTableExpr[T, WrappedPrimitive[int64]] {
+	if start == nil || end == nil {
+		return &ExprStruct[T, WrappedPrimitive[int64]]{
+			errs: []error{errors.New("timestampdiff expr is nil")},
+		}
+	}
+
+	unitQuery, err := unit.keyword()
+	if err != nil {
+		return &ExprStruct[T, WrappedPrimitive[int64]]{
+			errs: []error{fmt.Errorf("timestampdiff: %w", err)},
+		}
+	}
+
+	startQuery, startArgs, errs := start.Expr()
+	if len(errs) != 0 {
+		return &ExprStruct[T, WrappedPrimitive[int64]]{
+			errs: errs,
+		}
+	}
+
+	endQuery, endArgs, errs := end.Expr()
+	if len(errs) != 0 {
+		return &ExprStruct[T, WrappedPrimitive[int64]]{
+			errs: errs,
+		}
+	}
+
+	return newDialectExpr[T, WrappedPrimitive[int64]](func(dialect Dialect) (string, []ExprType, error) {
+		var (
+			query string
+			args  []ExprType
+		)
+		switch dialect {
+		case MySQL:
+			query = fmt.Sprintf("TIMESTAMPDIFF(%s, %s, %s)", unitQuery, startQuery, endQuery)
+			args = append(args, startArgs...)
+			args = append(args, endArgs...)
+		case PostgreSQL:
+			switch unit {
+			case Year:
+				query = fmt.Sprintf("CAST(EXTRACT(YEAR FROM AGE(%s, %s)) AS BIGINT)", endQuery, startQuery)
+				args = append(args, endArgs...)
+				args = append(args, startArgs...)
+			case Month:
+				query = fmt.Sprintf(
+					"CAST(EXTRACT(YEAR FROM AGE(%[1]s, %[2]s)) * 12 + EXTRACT(MONTH FROM AGE(%[1]s, %[2]s)) AS BIGINT)",
+					endQuery, startQuery,
+				)
+				args = append(args, endArgs...)
+				args = append(args, startArgs...)
+				args = append(args, endArgs...)
+				args = append(args, startArgs...)
+			default:
+				query = fmt.Sprintf(
+					"CAST(TRUNC(EXTRACT(EPOCH FROM (%s - %s)) / %d) AS BIGINT)",
+					endQuery, startQuery, postgresSecondsPerUnit[unit],
+				)
+				args = append(args, endArgs...)
+				args = append(args, startArgs...)
+			}
+		default:
+			return "", nil, fmt.Errorf("timestampdiff: %w", dialect.validate())
+		}
+
+		return query, args, nil
+	})
+}
@@ -0,0 +1,525 @@
+package genorm_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mazrean/genorm"
+	"github.com/mazrean/genorm/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+// resolveExpr render expr with dialect as the query builder does
+func resolveExpr(dialect genorm.Dialect, expr genorm.Expr) (string, []genorm.ExprType, error) {
+	query, args, errs := expr.Expr()
+	if len(errs) != 0 {
+		return "", nil, errs[0]
+	}
+
+	return genorm.ResolveDialectExprs(dialect, query, args)
+}
+
+func TestNow(t *testing.T) {
+	t.Parallel()
+
+	var res genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[time.Time]] = genorm.Now[*mock.MockTable]()
+
+	query, args, errs := res.Expr()
+	assert.Nil(t, errs)
+	assert.Equal(t, "NOW()", query)
+	assert.Nil(t, args)
+}
+
+func TestDate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description   string
+		exprIsNil     bool
+		exprQuery     string
+		exprArgs      []genorm.ExprType
+		exprErrs      []error
+		expectedQuery string
+		expectedArgs  []genorm.ExprType
+		isError       bool
+	}{
+		{
+			description:   "normal",
+			exprQuery:     "hoge.huga",
+			expectedQuery: "CAST(hoge.huga AS DATE)",
+		},
+		{
+			description:   "with args",
+			exprQuery:     "COALESCE(hoge.huga, ?)",
+			exprArgs:      []genorm.ExprType{genorm.Wrap(time.Unix(0, 0))},
+			expectedQuery: "CAST(COALESCE(hoge.huga, ?) AS DATE)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(time.Unix(0, 0))},
+		},
+		{
+			description: "nil expr",
+			exprIsNil:   true,
+			isError:     true,
+		},
+		{
+			description: "expr error",
+			exprErrs:    []error{errors.New("expr error")},
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[time.Time]]
+			if !test.exprIsNil {
+				mockExpr := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[time.Time]](ctrl)
+				expr = mockExpr
+
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return(test.exprQuery, test.exprArgs, test.exprErrs)
+			}
+
+			query, args, errs := genorm.Date(expr).Expr()
+			if test.isError {
+				assert.NotEmpty(t, errs)
+				return
+			}
+
+			if !assert.Nil(t, errs) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
+
+func TestDateTrunc(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description   string
+		dialect       genorm.Dialect
+		unit          genorm.TimeUnit
+		exprIsNil     bool
+		exprErrs      []error
+		expectedQuery string
+		isError       bool
+	}{
+		{
+			description:   "mysql day",
+			dialect:       genorm.MySQL,
+			unit:          genorm.Day,
+			expectedQuery: "STR_TO_DATE(DATE_FORMAT(hoge.huga, '%Y-%m-%d 00:00:00'), '%Y-%m-%d %H:%i:%s')",
+		},
+		{
+			description:   "mysql month",
+			dialect:       genorm.MySQL,
+			unit:          genorm.Month,
+			expectedQuery: "STR_TO_DATE(DATE_FORMAT(hoge.huga, '%Y-%m-01 00:00:00'), '%Y-%m-%d %H:%i:%s')",
+		},
+		{
+			description:   "postgres day",
+			dialect:       genorm.PostgreSQL,
+			unit:          genorm.Day,
+			expectedQuery: "DATE_TRUNC('DAY', hoge.huga)",
+		},
+		{
+			description: "invalid unit",
+			dialect:     genorm.MySQL,
+			unit:        0,
+			isError:     true,
+		},
+		{
+			description: "invalid dialect",
+			dialect:     0,
+			unit:        genorm.Day,
+			isError:     true,
+		},
+		{
+			description: "nil expr",
+			dialect:     genorm.MySQL,
+			unit:        genorm.Day,
+			exprIsNil:   true,
+			isError:     true,
+		},
+		{
+			description: "expr error",
+			dialect:     genorm.MySQL,
+			unit:        genorm.Day,
+			exprErrs:    []error{errors.New("expr error")},
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[time.Time]]
+			if !test.exprIsNil {
+				mockExpr := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[time.Time]](ctrl)
+				expr = mockExpr
+
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return("hoge.huga", nil, test.exprErrs).
+					AnyTimes()
+			}
+
+			query, args, err := resolveExpr(test.dialect, genorm.DateTrunc(test.unit, expr))
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
+			assert.Empty(t, args)
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description   string
+		dialect       genorm.Dialect
+		unit          genorm.TimeUnit
+		exprIsNil     bool
+		exprErrs      []error
+		expectedQuery string
+		isError       bool
+	}{
+		{
+			description:   "mysql year",
+			dialect:       genorm.MySQL,
+			unit:          genorm.Year,
+			expectedQuery: "EXTRACT(YEAR FROM hoge.huga)",
+		},
+		{
+			description:   "mysql hour",
+			dialect:       genorm.MySQL,
+			unit:          genorm.Hour,
+			expectedQuery: "EXTRACT(HOUR FROM hoge.huga)",
+		},
+		{
+			description:   "postgres second",
+			dialect:       genorm.PostgreSQL,
+			unit:          genorm.Second,
+			expectedQuery: "CAST(EXTRACT(SECOND FROM hoge.huga) AS BIGINT)",
+		},
+		{
+			description: "invalid unit",
+			dialect:     genorm.MySQL,
+			unit:        0,
+			isError:     true,
+		},
+		{
+			description: "invalid dialect",
+			dialect:     0,
+			unit:        genorm.Year,
+			isError:     true,
+		},
+		{
+			description: "nil expr",
+			dialect:     genorm.MySQL,
+			unit:        genorm.Year,
+			exprIsNil:   true,
+			isError:     true,
+		},
+		{
+			description: "expr error",
+			dialect:     genorm.MySQL,
+			unit:        genorm.Year,
+			exprErrs:    []error{errors.New("expr error")},
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[time.Time]]
+			if !test.exprIsNil {
+				mockExpr := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[time.Time]](ctrl)
+				expr = mockExpr
+
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return("hoge.huga", nil, test.exprErrs).
+					AnyTimes()
+			}
+
+			var res genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]] = genorm.Extract(test.unit, expr)
+
+			query, args, err := resolveExpr(test.dialect, res)
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
+			assert.Empty(t, args)
+		})
+	}
+}
+
+func TestDateAddSub(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description   string
+		isSub         bool
+		dialect       genorm.Dialect
+		interval      genorm.Interval
+		exprIsNil     bool
+		exprArgs      []genorm.ExprType
+		exprErrs      []error
+		expectedQuery string
+		expectedArgs  []genorm.ExprType
+		isError       bool
+	}{
+		{
+			description:   "mysql add",
+			dialect:       genorm.MySQL,
+			interval:      genorm.Interval{Value: 1, Unit: genorm.Day},
+			expectedQuery: "DATE_ADD(hoge.huga, INTERVAL ? DAY)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(int64(1))},
+		},
+		{
+			description:   "mysql sub with args",
+			isSub:         true,
+			dialect:       genorm.MySQL,
+			interval:      genorm.Interval{Value: 3, Unit: genorm.Month},
+			exprArgs:      []genorm.ExprType{genorm.Wrap(1)},
+			expectedQuery: "DATE_SUB(hoge.huga, INTERVAL ? MONTH)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(int64(3))},
+		},
+		{
+			description:   "postgres add",
+			dialect:       genorm.PostgreSQL,
+			interval:      genorm.Interval{Value: 2, Unit: genorm.Hour},
+			expectedQuery: "(hoge.huga + ? * INTERVAL '1 HOUR')",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(int64(2))},
+		},
+		{
+			description:   "postgres sub",
+			isSub:         true,
+			dialect:       genorm.PostgreSQL,
+			interval:      genorm.Interval{Value: 30, Unit: genorm.Minute},
+			expectedQuery: "(hoge.huga - ? * INTERVAL '1 MINUTE')",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(int64(30))},
+		},
+		{
+			description: "invalid unit",
+			dialect:     genorm.MySQL,
+			interval:    genorm.Interval{Value: 1},
+			isError:     true,
+		},
+		{
+			description: "invalid dialect",
+			interval:    genorm.Interval{Value: 1, Unit: genorm.Day},
+			isError:     true,
+		},
+		{
+			description: "nil expr",
+			dialect:     genorm.MySQL,
+			interval:    genorm.Interval{Value: 1, Unit: genorm.Day},
+			exprIsNil:   true,
+			isError:     true,
+		},
+		{
+			description: "expr error",
+			dialect:     genorm.MySQL,
+			interval:    genorm.Interval{Value: 1, Unit: genorm.Day},
+			exprErrs:    []error{errors.New("expr error")},
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[time.Time]]
+			if !test.exprIsNil {
+				mockExpr := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[time.Time]](ctrl)
+				expr = mockExpr
+
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return("hoge.huga", test.exprArgs, test.exprErrs).
+					AnyTimes()
+			}
+
+			var res genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[time.Time]]
+			if test.isSub {
+				res = genorm.DateSub(expr, test.interval)
+			} else {
+				res = genorm.DateAdd(expr, test.interval)
+			}
+
+			query, args, err := resolveExpr(test.dialect, res)
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
+
+func TestTimestampDiff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description   string
+		dialect       genorm.Dialect
+		unit          genorm.TimeUnit
+		startIsNil    bool
+		endIsNil      bool
+		startErrs     []error
+		endErrs       []error
+		expectedQuery string
+		expectedArgs  []genorm.ExprType
+		isError       bool
+	}{
+		{
+			description:   "mysql",
+			dialect:       genorm.MySQL,
+			unit:          genorm.Day,
+			expectedQuery: "TIMESTAMPDIFF(DAY, (hoge.start + ?), (hoge.end + ?))",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(2)},
+		},
+		{
+			description:   "postgres day",
+			dialect:       genorm.PostgreSQL,
+			unit:          genorm.Day,
+			expectedQuery: "CAST(TRUNC(EXTRACT(EPOCH FROM ((hoge.end + ?) - (hoge.start + ?))) / 86400) AS BIGINT)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(2), genorm.Wrap(1)},
+		},
+		{
+			description:   "postgres year",
+			dialect:       genorm.PostgreSQL,
+			unit:          genorm.Year,
+			expectedQuery: "CAST(EXTRACT(YEAR FROM AGE((hoge.end + ?), (hoge.start + ?))) AS BIGINT)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(2), genorm.Wrap(1)},
+		},
+		{
+			description:   "postgres month",
+			dialect:       genorm.PostgreSQL,
+			unit:          genorm.Month,
+			expectedQuery: "CAST(EXTRACT(YEAR FROM AGE((hoge.end + ?), (hoge.start + ?))) * 12 + EXTRACT(MONTH FROM AGE((hoge.end + ?), (hoge.start + ?))) AS BIGINT)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(2), genorm.Wrap(1), genorm.Wrap(2), genorm.Wrap(1)},
+		},
+		{
+			description: "invalid unit",
+			dialect:     genorm.MySQL,
+			unit:        0,
+			isError:     true,
+		},
+		{
+			description: "invalid dialect",
+			unit:        genorm.Day,
+			isError:     true,
+		},
+		{
+			description: "nil start",
+			dialect:     genorm.MySQL,
+			unit:        genorm.Day,
+			startIsNil:  true,
+			isError:     true,
+		},
+		{
+			description: "nil end",
+			dialect:     genorm.MySQL,
+			unit:        genorm.Day,
+			endIsNil:    true,
+			isError:     true,
+		},
+		{
+			description: "start error",
+			dialect:     genorm.MySQL,
+			unit:        genorm.Day,
+			startErrs:   []error{errors.New("start error")},
+			isError:     true,
+		},
+		{
+			description: "end error",
+			dialect:     genorm.MySQL,
+			unit:        genorm.Day,
+			endErrs:     []error{errors.New("end error")},
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var start genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[time.Time]]
+			if !test.startIsNil {
+				mockExpr := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[time.Time]](ctrl)
+				start = mockExpr
+
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return("(hoge.start + ?)", []genorm.ExprType{genorm.Wrap(1)}, test.startErrs).
+					AnyTimes()
+			}
+
+			var end genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[time.Time]]
+			if !test.endIsNil {
+				mockExpr := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[time.Time]](ctrl)
+				end = mockExpr
+
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return("(hoge.end + ?)", []genorm.ExprType{genorm.Wrap(2)}, test.endErrs).
+					AnyTimes()
+			}
+
+			var res genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]] = genorm.TimestampDiff(test.unit, start, end)
+
+			query, args, err := resolveExpr(test.dialect, res)
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
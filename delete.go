@@ -21,9 +21,18 @@ func Delete[T BasicTable](table T) *DeleteContext[T] {
 	}
 }
 
+func (c *DeleteContext[T]) clone() *DeleteContext[T] {
+	clone := *c
+	clone.Context = c.Context.clone()
+
+	return &clone
+}
+
 func (c *DeleteContext[T]) Where(
 	condition TypedTableExpr[T, WrappedPrimitive[bool]],
 ) *DeleteContext[T] {
+	c = c.clone()
+
 	err := c.whereCondition.set(condition)
 	if err != nil {
 		c.addError(fmt.Errorf("where condition: %w", err))
@@ -32,7 +41,22 @@ func (c *DeleteContext[T]) Where(
 	return c
 }
 
+func (c *DeleteContext[T]) OrWhere(
+	condition TypedTableExpr[T, WrappedPrimitive[bool]],
+) *DeleteContext[T] {
+	c = c.clone()
+
+	err := c.whereCondition.or(condition)
+	if err != nil {
+		c.addError(fmt.Errorf("where condition: %w", err))
+	}
+
+	return c
+}
+
 func (c *DeleteContext[T]) OrderBy(direction OrderDirection, expr TableExpr[T]) *DeleteContext[T] {
+	c = c.clone()
+
 	err := c.order.add(orderItem[T]{
 		expr:      expr,
 		direction: direction,
@@ -45,6 +69,8 @@ func (c *DeleteContext[T]) OrderBy(direction OrderDirection, expr TableExpr[T])
 }
 
 func (c *DeleteContext[T]) Limit(limit uint64) *DeleteContext[T] {
+	c = c.clone()
+
 	err := c.limit.set(limit)
 	if err != nil {
 		c.addError(fmt.Errorf("limit: %w", err))
@@ -163,5 +189,5 @@ func (c *DeleteContext[T]) buildQuery() (string, []ExprType, error) {
 		args = append(args, limitArgs...)
 	}
 
-	return sb.String(), args, nil
+	return c.resolveDialectExprs(sb.String(), args)
 }
@@ -3,3 +3,7 @@ package genorm
 func (c *DeleteContext[T]) BuildQuery() (string, []ExprType, error) {
 	return c.buildQuery()
 }
+
+func (c *DeleteJoinedContext[T, B]) BuildQuery() (string, []ExprType, error) {
+	return c.buildQuery()
+}
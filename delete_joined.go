@@ -0,0 +1,226 @@
+package genorm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+/*
+DeleteJoinedContext
+DELETE target FROM joined_table WHERE ...(MySQL)
+DELETE FROM target USING other_table WHERE join_condition AND ...(PostgreSQL)
+*/
+type DeleteJoinedContext[T JoinedTable, B BasicTable] struct {
+	*Context[T]
+	target         B
+	whereCondition whereConditionClause[T]
+}
+
+// DeleteJoined delete the rows of target, which is one of the base tables of table
+func DeleteJoined[T JoinedTable, B BasicTable](table T, target B) *DeleteJoinedContext[T, B] {
+	ctx := newContext(table)
+
+	err := validateJoinedTarget(table, target)
+	if err != nil {
+		ctx.addError(fmt.Errorf("target: %w", err))
+	}
+
+	return &DeleteJoinedContext[T, B]{
+		Context: ctx,
+		target:  target,
+	}
+}
+
+func (c *DeleteJoinedContext[T, B]) clone() *DeleteJoinedContext[T, B] {
+	clone := *c
+	clone.Context = c.Context.clone()
+
+	return &clone
+}
+
+func (c *DeleteJoinedContext[T, B]) Where(
+	condition TypedTableExpr[T, WrappedPrimitive[bool]],
+) *DeleteJoinedContext[T, B] {
+	c = c.clone()
+
+	err := c.whereCondition.set(condition)
+	if err != nil {
+		c.addError(fmt.Errorf("where condition: %w", err))
+	}
+
+	return c
+}
+
+func (c *DeleteJoinedContext[T, B]) OrWhere(
+	condition TypedTableExpr[T, WrappedPrimitive[bool]],
+) *DeleteJoinedContext[T, B] {
+	c = c.clone()
+
+	err := c.whereCondition.or(condition)
+	if err != nil {
+		c.addError(fmt.Errorf("where condition: %w", err))
+	}
+
+	return c
+}
+
+func (c *DeleteJoinedContext[T, B]) Dialect(dialect Dialect) *DeleteJoinedContext[T, B] {
+	c = c.clone()
+
+	c.setDialect(dialect)
+
+	return c
+}
+
+func (c *DeleteJoinedContext[T, B]) DoCtx(ctx context.Context, db DB) (rowsAffected int64, err error) {
+	errs := c.Errors()
+	if len(errs) != 0 {
+		return 0, errs[0]
+	}
+
+	query, exprArgs, err := c.buildQuery()
+	if err != nil {
+		return 0, fmt.Errorf("build query: %w", err)
+	}
+
+	args := make([]any, 0, len(exprArgs))
+	for _, arg := range exprArgs {
+		args = append(args, arg)
+	}
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("exec: %w", err)
+	}
+
+	rowsAffected, err = result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+func (c *DeleteJoinedContext[T, B]) Do(db DB) (rowsAffected int64, err error) {
+	return c.DoCtx(context.Background(), db)
+}
+
+func (c *DeleteJoinedContext[T, B]) buildQuery() (string, []ExprType, error) {
+	if c.dialect == PostgreSQL {
+		return c.buildUsingQuery()
+	}
+
+	args := []ExprType{}
+
+	sb := strings.Builder{}
+
+	str := fmt.Sprintf("DELETE %s FROM ", c.target.TableName())
+	_, err := sb.WriteString(str)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+	}
+
+	tableQuery, tableArgs, err := c.tableExpr()
+	if err != nil {
+		return "", nil, fmt.Errorf("table expr: %w", err)
+	}
+
+	_, err = sb.WriteString(tableQuery)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", tableQuery, err)
+	}
+
+	args = append(args, tableArgs...)
+
+	if c.whereCondition.exists() {
+		whereQuery, whereArgs, err := c.whereCondition.getExpr()
+		if err != nil {
+			return "", nil, fmt.Errorf("where condition: %w", err)
+		}
+
+		str = " WHERE "
+		_, err = sb.WriteString(str)
+		if err != nil {
+			return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+		}
+
+		_, err = sb.WriteString(whereQuery)
+		if err != nil {
+			return "", nil, fmt.Errorf("write string(%s): %w", whereQuery, err)
+		}
+
+		args = append(args, whereArgs...)
+	}
+
+	return c.resolveDialectExprs(sb.String(), args)
+}
+
+// buildUsingQuery DELETE FROM target USING other_table WHERE join_condition AND ...
+func (c *DeleteJoinedContext[T, B]) buildUsingQuery() (string, []ExprType, error) {
+	args := []ExprType{}
+
+	sb := strings.Builder{}
+
+	str := "DELETE FROM "
+	_, err := sb.WriteString(str)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+	}
+
+	targetQuery, targetArgs, errs := c.target.Expr()
+	if len(errs) != 0 {
+		return "", nil, fmt.Errorf("target: %w", errs[0])
+	}
+
+	_, err = sb.WriteString(targetQuery)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", targetQuery, err)
+	}
+
+	args = append(args, targetArgs...)
+
+	usingQuery, usingArgs, onQuery, onArgs, err := detachTarget(c.table, c.target)
+	if err != nil {
+		return "", nil, fmt.Errorf("using table: %w", err)
+	}
+
+	str = " USING "
+	_, err = sb.WriteString(str)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+	}
+
+	_, err = sb.WriteString(usingQuery)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", usingQuery, err)
+	}
+
+	args = append(args, usingArgs...)
+
+	conditions := []string{}
+	if len(onQuery) != 0 {
+		conditions = append(conditions, onQuery)
+		args = append(args, onArgs...)
+	}
+
+	if c.whereCondition.exists() {
+		whereQuery, whereArgs, err := c.whereCondition.getExpr()
+		if err != nil {
+			return "", nil, fmt.Errorf("where condition: %w", err)
+		}
+
+		conditions = append(conditions, whereQuery)
+		args = append(args, whereArgs...)
+	}
+
+	if len(conditions) != 0 {
+		str = " WHERE " + strings.Join(conditions, " AND ")
+		_, err = sb.WriteString(str)
+		if err != nil {
+			return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+		}
+	}
+
+	return c.resolveDialectExprs(sb.String(), args)
+}
@@ -0,0 +1,133 @@
+package genorm_test
+
+import (
+	"testing"
+
+	"github.com/mazrean/genorm"
+	"github.com/mazrean/genorm/relation"
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	joinedTestPair      = relation.PairContext[aliasTestUser, *aliasTestUser, preloadTestMessage, *preloadTestMessage]
+	joinedTestPairTable = relation.PairTable[aliasTestUser, *aliasTestUser, preloadTestMessage, *preloadTestMessage]
+)
+
+func joinedTestInnerJoin(pair *joinedTestPair) *joinedTestPairTable {
+	return pair.Join(genorm.Eq(
+		relation.LeftExpr(pair, aliasTestUserID),
+		relation.RightExpr(pair, preloadTestMessageUserID),
+	))
+}
+
+func joinedTestLeftJoin(pair *joinedTestPair) *joinedTestPairTable {
+	return pair.LeftJoin(genorm.Eq(
+		relation.LeftExpr(pair, aliasTestUserID),
+		relation.RightExpr(pair, preloadTestMessageUserID),
+	))
+}
+
+func joinedTestCrossJoin(pair *joinedTestPair) *joinedTestPairTable {
+	return pair.CrossJoin()
+}
+
+func TestDeleteJoinedBuildQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		joinedTable func(*joinedTestPair) *joinedTestPairTable
+		target      genorm.BasicTable
+		dialect     genorm.Dialect
+		query       string
+		args        []genorm.ExprType
+		isError     bool
+	}{
+		{
+			description: "mysql",
+			joinedTable: joinedTestInnerJoin,
+			target:      &preloadTestMessage{},
+			dialect:     genorm.MySQL,
+			query:       "DELETE messages FROM (users INNER JOIN messages ON (users.id = messages.user_id)) WHERE (messages.id = ?)",
+			args:        []genorm.ExprType{genorm.Wrap[int64](1)},
+		},
+		{
+			description: "mysql base table",
+			joinedTable: joinedTestInnerJoin,
+			target:      &aliasTestUser{},
+			dialect:     genorm.MySQL,
+			query:       "DELETE users FROM (users INNER JOIN messages ON (users.id = messages.user_id)) WHERE (messages.id = ?)",
+			args:        []genorm.ExprType{genorm.Wrap[int64](1)},
+		},
+		{
+			description: "postgres",
+			joinedTable: joinedTestInnerJoin,
+			target:      &preloadTestMessage{},
+			dialect:     genorm.PostgreSQL,
+			query:       "DELETE FROM messages USING users WHERE (users.id = messages.user_id) AND (messages.id = ?)",
+			args:        []genorm.ExprType{genorm.Wrap[int64](1)},
+		},
+		{
+			description: "postgres cross join",
+			joinedTable: joinedTestCrossJoin,
+			target:      &preloadTestMessage{},
+			dialect:     genorm.PostgreSQL,
+			query:       "DELETE FROM messages USING users WHERE (messages.id = ?)",
+			args:        []genorm.ExprType{genorm.Wrap[int64](1)},
+		},
+		{
+			description: "postgres left join",
+			joinedTable: joinedTestLeftJoin,
+			target:      &preloadTestMessage{},
+			dialect:     genorm.PostgreSQL,
+			isError:     true,
+		},
+		{
+			description: "target not joined",
+			joinedTable: joinedTestInnerJoin,
+			target:      genorm.As[aliasTestManager](&aliasTestUser{}),
+			dialect:     genorm.MySQL,
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			pair := relation.Pair(&aliasTestUser{}, &preloadTestMessage{})
+
+			builder := genorm.
+				DeleteJoined(test.joinedTable(pair), test.target).
+				Dialect(test.dialect).
+				Where(genorm.EqLit(relation.RightExpr(pair, preloadTestMessageID), genorm.Wrap[int64](1)))
+
+			errs := builder.Errors()
+			if len(errs) != 0 {
+				assert.True(t, test.isError, "unexpected errors: %v", errs)
+				return
+			}
+
+			query, args, err := builder.BuildQuery()
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+			assert.Equal(t, test.args, args)
+		})
+	}
+}
+
+func TestDeleteJoinedTargetJoinedTwice(t *testing.T) {
+	t.Parallel()
+
+	pair := relation.Pair(&aliasTestUser{}, &aliasTestUser{})
+
+	builder := genorm.DeleteJoined(pair.CrossJoin(), &aliasTestUser{})
+
+	assert.NotEmpty(t, builder.Errors())
+}
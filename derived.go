@@ -0,0 +1,335 @@
+package genorm
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+/*
+DerivedTable
+(SELECT ...) AS alias_name
+Use it with relation.Pair to join a subquery.
+The columns are the values of the row(R) in order(alias_name.value1, alias_name.value2, ...).
+Use DerivedTupleN or DerivedPluck for the typed columns.
+*/
+type DerivedTable[A Alias, R TuplePointer[U], U any] struct {
+	row     U
+	query   func() (string, []ExprType, error)
+	dialect Dialect
+	errs    []error
+}
+
+// Derive (SELECT ... FROM ...) AS alias_name of the Find query
+func Derive[A Alias, S Table, T TuplePointer[U], U any](query *FindContext[S, T, U]) *DerivedTable[A, T, U] {
+	derivedTable := &DerivedTable[A, T, U]{}
+	if query == nil {
+		derivedTable.errs = []error{errors.New("nil query")}
+		return derivedTable
+	}
+
+	derivedTable.query = func() (string, []ExprType, error) {
+		return query.buildAliasedQuery(derivedColumnName)
+	}
+	derivedTable.dialect = query.dialect
+	derivedTable.errs = slices.Clone(query.Errors())
+
+	if query.keyset.exists() {
+		derivedTable.errs = append(derivedTable.errs, errors.New("paginate in derived table"))
+	}
+
+	return derivedTable
+}
+
+// DerivePluck (SELECT ... FROM ...) AS alias_name of the Pluck query
+func DerivePluck[A Alias, T Table, S ExprType](query *PluckContext[T, S]) *DerivedTable[A, *PluckRow[S], PluckRow[S]] {
+	derivedTable := &DerivedTable[A, *PluckRow[S], PluckRow[S]]{}
+	if query == nil {
+		derivedTable.errs = []error{errors.New("nil query")}
+		return derivedTable
+	}
+
+	derivedTable.query = func() (string, []ExprType, error) {
+		return query.buildAliasedQuery(derivedColumnName(0))
+	}
+	derivedTable.dialect = query.dialect
+	derivedTable.errs = slices.Clone(query.Errors())
+
+	if len(derivedTable.Row().Columns()) == 0 {
+		var value S
+		derivedTable.errs = append(derivedTable.errs, fmt.Errorf("%T is not a sql.Scanner", &value))
+	}
+
+	return derivedTable
+}
+
+// Row values of the derived table
+func (d *DerivedTable[_, R, _]) Row() R {
+	return R(&d.row)
+}
+
+// TableName alias_name
+func (*DerivedTable[A, _, _]) TableName() string {
+	var alias A
+	return alias.AliasName()
+}
+
+func (d *DerivedTable[_, _, _]) Expr() (string, []ExprType, []error) {
+	query, args, errs := d.expr()
+	if len(errs) != 0 {
+		return "", nil, errs
+	}
+
+	for _, arg := range args {
+		if oe, ok := arg.(*outerExpr); ok {
+			return "", nil, []error{fmt.Errorf("outer column %s in derived table %s without LATERAL", oe.column.SQLColumnName(), d.TableName())}
+		}
+	}
+
+	return query, args, nil
+}
+
+// LateralExpr LATERAL (SELECT ...) AS alias_name, whose Outer columns are columns of outer
+func (d *DerivedTable[_, _, _]) LateralExpr(outer Table) (string, []ExprType, []error) {
+	query, args, errs := d.expr()
+	if len(errs) != 0 {
+		return "", nil, errs
+	}
+
+	query, args, err := resolveOuterExprs(outer, query, args)
+	if err != nil {
+		return "", nil, []error{fmt.Errorf("derived table %s: %w", d.TableName(), err)}
+	}
+
+	return "LATERAL " + query, args, nil
+}
+
+// expr (SELECT ...) AS alias_name, whose Outer columns are not resolved
+func (d *DerivedTable[_, _, _]) expr() (string, []ExprType, []error) {
+	errs := d.GetErrors()
+	if len(errs) != 0 {
+		return "", nil, errs
+	}
+
+	if d.query == nil {
+		return "", nil, []error{errors.New("no query")}
+	}
+
+	query, args, err := d.query()
+	if err != nil {
+		return "", nil, []error{fmt.Errorf("derived table query: %w", err)}
+	}
+
+	return fmt.Sprintf("(%s) AS %s", query, d.TableName()), args, nil
+}
+
+func (d *DerivedTable[_, R, _]) Columns() []Column {
+	fields := R(&d.row).Columns()
+
+	columns := make([]Column, 0, len(fields))
+	for i := range fields {
+		columns = append(columns, &derivedColumn{
+			aliasName:  d.TableName(),
+			columnName: derivedColumnName(i),
+		})
+	}
+
+	return columns
+}
+
+// ColumnMap key: alias_name.valueN
+func (d *DerivedTable[_, R, _]) ColumnMap() map[string]ColumnFieldExprType {
+	fields := R(&d.row).Columns()
+
+	columnMap := make(map[string]ColumnFieldExprType, len(fields))
+	for i, field := range fields {
+		columnMap[fmt.Sprintf("%s.%s", d.TableName(), derivedColumnName(i))] = field
+	}
+
+	return columnMap
+}
+
+func (d *DerivedTable[_, _, _]) GetErrors() []error {
+	errs := slices.Clone(d.errs)
+
+	aliasName := d.TableName()
+	if !identifierRegexp.MatchString(aliasName) {
+		errs = append(errs, fmt.Errorf("invalid alias name: %s", aliasName))
+	}
+
+	return errs
+}
+
+// ValidateDialect the dialect of the subquery must be the same as that of the outer query
+func (d *DerivedTable[_, _, _]) ValidateDialect(dialect Dialect) error {
+	if d.query != nil && d.dialect != dialect {
+		return fmt.Errorf("dialect of derived table %s is different from the outer query", d.TableName())
+	}
+
+	return nil
+}
+
+// PluckRow row of the derived table of Pluck
+type PluckRow[S ExprType] struct {
+	value S
+}
+
+func (*PluckRow[_]) Exprs() []Expr {
+	return nil
+}
+
+func (r *PluckRow[_]) Columns() []ColumnFieldExprType {
+	field, ok := any(&r.value).(ColumnFieldExprType)
+	if !ok {
+		return nil
+	}
+
+	return []ColumnFieldExprType{field}
+}
+
+func (r *PluckRow[S]) Value() S {
+	return r.value
+}
+
+// derivedColumnName column name of the position(0-based) in the derived table(valueN, N: 1-based)
+func derivedColumnName(position int) string {
+	return fmt.Sprintf("value%d", position+1)
+}
+
+type derivedColumn struct {
+	aliasName  string
+	columnName string
+}
+
+func (c *derivedColumn) Expr() (string, []ExprType, []error) {
+	return c.SQLColumnName(), nil, nil
+}
+
+// SQLColumnName alias_name.valueN
+func (c *derivedColumn) SQLColumnName() string {
+	return fmt.Sprintf("%s.%s", c.aliasName, c.columnName)
+}
+
+// TableName alias_name
+func (c *derivedColumn) TableName() string {
+	return c.aliasName
+}
+
+func (c *derivedColumn) ColumnName() string {
+	return c.columnName
+}
+
+type derivedTypedColumn[A Alias, R TuplePointer[U], U any, V ExprType] struct {
+	derivedColumn
+}
+
+// newDerivedTypedColumn column of the position(0-based), whose type is checked by the generated DerivedTupleN
+func newDerivedTypedColumn[V ExprType, A Alias, R TuplePointer[U], U any](position int) *derivedTypedColumn[A, R, U, V] {
+	var table DerivedTable[A, R, U]
+
+	return &derivedTypedColumn[A, R, U, V]{
+		derivedColumn: derivedColumn{
+			aliasName:  table.TableName(),
+			columnName: derivedColumnName(position),
+		},
+	}
+}
+
+func (c *derivedTypedColumn[A, R, U, _]) TableExpr(*DerivedTable[A, R, U]) (string, []ExprType, []error) {
+	return c.Expr()
+}
+
+func (c *derivedTypedColumn[_, _, _, V]) TypedExpr(V) (string, []ExprType, []error) {
+	return c.Expr()
+}
+
+// DerivedPluckStruct typed column of the derived table of Pluck
+type DerivedPluckStruct[D Table, S ExprType] struct {
+	Value     TypedTableColumns[D, S]
+	ValueExpr TypedTableExpr[D, S]
+}
+
+// DerivedPluck typed column of the derived table of Pluck(alias_name.value1)
+func DerivedPluck[A Alias, S ExprType](
+	_ *DerivedTable[A, *PluckRow[S], PluckRow[S]],
+) *DerivedPluckStruct[*DerivedTable[A, *PluckRow[S], PluckRow[S]], S] {
+	return &DerivedPluckStruct[*DerivedTable[A, *PluckRow[S], PluckRow[S]], S]{
+		Value:     newDerivedTypedColumn[S, A, *PluckRow[S]](0),
+		ValueExpr: newDerivedTypedColumn[S, A, *PluckRow[S]](0),
+	}
+}
+
+/*
+outerExpr
+column of the outer table referenced in the subquery of LATERAL join.
+It is embedded in the query as a placeholder and rendered by resolveOuterExprs.
+*/
+type outerExpr struct {
+	column Column
+	// isOuter whether the table is the outer table of the column
+	isOuter func(Table) bool
+}
+
+func (oe *outerExpr) Value() (driver.Value, error) {
+	return nil, fmt.Errorf("outer column %s is not in LATERAL subquery", oe.column.SQLColumnName())
+}
+
+/*
+Outer
+column of the outer query referenced in the subquery of LATERAL join.
+The table of the column(S) must be the base table of the LATERAL join, or one of its base tables.
+e.g. genorm.Outer[*orm.MessageTable](user.ID)
+*/
+func Outer[T Table, S Table, V ExprType](column TypedTableColumns[S, V]) TypedTableExpr[T, V] {
+	if column == nil {
+		return &ExprStruct[T, V]{
+			errs: []error{errors.New("outer column is nil")},
+		}
+	}
+
+	return &ExprStruct[T, V]{
+		query: "?",
+		args: []ExprType{&outerExpr{
+			column: column,
+			isOuter: func(table Table) bool {
+				if _, ok := table.(S); ok {
+					return true
+				}
+
+				joinedTable, ok := table.(interface{ BaseTables() []BasicTable })
+				if !ok {
+					return false
+				}
+
+				for _, baseTable := range joinedTable.BaseTables() {
+					if _, ok := baseTable.(S); ok {
+						return true
+					}
+				}
+
+				return false
+			},
+		}},
+	}
+}
+
+/*
+resolveOuterExprs
+replace the placeholders of the outer columns in args with the columns of outer.
+The placeholders are matched to args by position(see resolvePlaceholders).
+*/
+func resolveOuterExprs(outer Table, query string, args []ExprType) (string, []ExprType, error) {
+	return resolvePlaceholders(query, args, func(arg ExprType) (string, []ExprType, bool, error) {
+		oe, ok := arg.(*outerExpr)
+		if !ok {
+			return "", nil, false, nil
+		}
+
+		if !oe.isOuter(outer) {
+			return "", nil, false, fmt.Errorf("outer column %s is not a column of the base table of LATERAL join", oe.column.SQLColumnName())
+		}
+
+		return oe.column.SQLColumnName(), nil, true, nil
+	})
+}
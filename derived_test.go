@@ -0,0 +1,365 @@
+package genorm_test
+
+import (
+	"testing"
+
+	"github.com/mazrean/genorm"
+	"github.com/mazrean/genorm/relation"
+	"github.com/stretchr/testify/assert"
+)
+
+type derivedTestLatest struct{}
+
+func (derivedTestLatest) AliasName() string {
+	return "latest"
+}
+
+type derivedTestTuple = genorm.Tuple2Struct[*preloadTestMessage, genorm.WrappedPrimitive[int64], *genorm.WrappedPrimitive[int64], genorm.WrappedPrimitive[int64], *genorm.WrappedPrimitive[int64]]
+
+func derivedTestFind() *genorm.FindContext[*preloadTestMessage, *derivedTestTuple, derivedTestTuple] {
+	return genorm.
+		Find(&preloadTestMessage{}, genorm.Tuple2(preloadTestMessageUserID, genorm.Max(preloadTestMessageID))).
+		GroupBy(preloadTestMessageUserID)
+}
+
+func TestDerivedTable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		table       genorm.BasicTable
+		query       string
+		args        []genorm.ExprType
+		columnNames []string
+		isError     bool
+	}{
+		{
+			description: "find",
+			table:       genorm.Derive[derivedTestLatest](derivedTestFind()),
+			query:       "(SELECT messages.user_id AS value1, MAX(messages.id) AS value2 FROM messages GROUP BY messages.user_id) AS latest",
+			args:        []genorm.ExprType{},
+			columnNames: []string{"latest.value1", "latest.value2"},
+		},
+		{
+			description: "find with args",
+			table: genorm.Derive[derivedTestLatest](derivedTestFind().
+				Where(genorm.EqLit(preloadTestMessageUserID, genorm.Wrap[int64](1)))),
+			query:       "(SELECT messages.user_id AS value1, MAX(messages.id) AS value2 FROM messages WHERE (messages.user_id = ?) GROUP BY messages.user_id) AS latest",
+			args:        []genorm.ExprType{genorm.Wrap[int64](1)},
+			columnNames: []string{"latest.value1", "latest.value2"},
+		},
+		{
+			description: "pluck",
+			table:       genorm.DerivePluck[derivedTestLatest](genorm.Pluck(&preloadTestMessage{}, preloadTestMessageUserID)),
+			query:       "(SELECT messages.user_id AS value1 FROM messages) AS latest",
+			args:        []genorm.ExprType{},
+			columnNames: []string{"latest.value1"},
+		},
+		{
+			description: "nil query",
+			table:       genorm.Derive[derivedTestLatest, *preloadTestMessage, *derivedTestTuple](nil),
+			isError:     true,
+		},
+		{
+			description: "paginate",
+			table:       genorm.Derive[derivedTestLatest](derivedTestFind().Paginate(10, genorm.Asc, preloadTestMessageUserID)),
+			isError:     true,
+		},
+		{
+			description: "invalid alias",
+			table:       genorm.Derive[aliasTestInvalid](derivedTestFind()),
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			query, args, errs := test.table.Expr()
+			if test.isError {
+				assert.NotEmpty(t, errs)
+				return
+			}
+
+			if !assert.Empty(t, errs) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+			assert.Equal(t, test.args, args)
+
+			columns := test.table.Columns()
+			columnMap := test.table.ColumnMap()
+			columnNames := make([]string, 0, len(columns))
+			for _, column := range columns {
+				columnNames = append(columnNames, column.SQLColumnName())
+				assert.Contains(t, columnMap, column.SQLColumnName())
+			}
+			assert.Equal(t, test.columnNames, columnNames)
+		})
+	}
+}
+
+func TestDerivedTableValidateDialect(t *testing.T) {
+	t.Parallel()
+
+	table := genorm.Derive[derivedTestLatest](derivedTestFind().Dialect(genorm.PostgreSQL))
+
+	assert.NoError(t, table.ValidateDialect(genorm.PostgreSQL))
+	assert.Error(t, table.ValidateDialect(genorm.MySQL))
+}
+
+func TestDerivedTuple(t *testing.T) {
+	t.Parallel()
+
+	table := genorm.Derive[derivedTestLatest](derivedTestFind())
+	columns := genorm.DerivedTuple2(table)
+
+	tests := []struct {
+		description string
+		column      genorm.Column
+		query       string
+	}{
+		{
+			description: "value1",
+			column:      columns.Value1,
+			query:       "latest.value1",
+		},
+		{
+			description: "value2",
+			column:      columns.Value2,
+			query:       "latest.value2",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			query, args, errs := test.column.Expr()
+			assert.Empty(t, errs)
+			assert.Equal(t, test.query, query)
+			assert.Empty(t, args)
+			assert.Equal(t, "latest", test.column.TableName())
+			assert.Equal(t, test.query, test.column.SQLColumnName())
+		})
+	}
+
+	query, _, errs := columns.Value2Expr.Expr()
+	assert.Empty(t, errs)
+	assert.Equal(t, "latest.value2", query)
+
+	// the column names of the derived table are the same as those of Columns
+	tableColumns := table.Columns()
+	if assert.Len(t, tableColumns, 2) {
+		assert.Equal(t, tableColumns[0].SQLColumnName(), columns.Value1.SQLColumnName())
+		assert.Equal(t, tableColumns[1].SQLColumnName(), columns.Value2.SQLColumnName())
+	}
+}
+
+func TestDerivedPluck(t *testing.T) {
+	t.Parallel()
+
+	table := genorm.DerivePluck[derivedTestLatest](genorm.Pluck(&preloadTestMessage{}, preloadTestMessageUserID))
+	column := genorm.DerivedPluck(table)
+
+	query, _, errs := column.Value.Expr()
+	assert.Empty(t, errs)
+	assert.Equal(t, "latest.value1", query)
+
+	query, _, errs = column.ValueExpr.Expr()
+	assert.Empty(t, errs)
+	assert.Equal(t, "latest.value1", query)
+}
+
+func TestDerivedJoinSelect(t *testing.T) {
+	t.Parallel()
+
+	latest := genorm.Derive[derivedTestLatest](derivedTestFind())
+	latestColumns := genorm.DerivedTuple2(latest)
+	latestUserID := latestColumns.Value1
+	latestMessageID := latestColumns.Value2
+
+	pair := relation.Pair(&aliasTestUser{}, latest)
+	joinedTable := pair.LeftJoin(genorm.Eq(
+		relation.LeftExpr(pair, aliasTestUserID),
+		relation.RightExpr(pair, latestUserID),
+	))
+
+	columns, query, args, err := genorm.
+		Select(joinedTable).
+		Fields(relation.Left(pair, aliasTestUserID), relation.Right(pair, latestMessageID)).
+		Where(genorm.IsNotNull(relation.RightExpr(pair, latestMessageID))).
+		BuildQuery()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "SELECT users.id AS users_id_0, latest.value2 AS latest_value2_0 FROM (users LEFT JOIN (SELECT messages.user_id AS value1, MAX(messages.id) AS value2 FROM messages GROUP BY messages.user_id) AS latest ON (users.id = latest.value1)) WHERE (latest.value2 IS NOT NULL)", query)
+	assert.Empty(t, args)
+
+	columnMap := joinedTable.ColumnMap()
+	if assert.Len(t, columns, 2) {
+		assert.Same(t, &joinedTable.Left().ID, columnMap[columns[0].SQLColumnName()])
+		assert.Same(t, joinedTable.Right().Row().Columns()[1], columnMap[columns[1].SQLColumnName()])
+	}
+}
+
+type (
+	derivedTestPair      = relation.PairContext[aliasTestUser, *aliasTestUser, genorm.DerivedTable[derivedTestLatest, *derivedTestTuple, derivedTestTuple], *genorm.DerivedTable[derivedTestLatest, *derivedTestTuple, derivedTestTuple]]
+	derivedTestPairTable = relation.PairTable[aliasTestUser, *aliasTestUser, genorm.DerivedTable[derivedTestLatest, *derivedTestTuple, derivedTestTuple], *genorm.DerivedTable[derivedTestLatest, *derivedTestTuple, derivedTestTuple]]
+)
+
+func TestLateralJoinSelect(t *testing.T) {
+	t.Parallel()
+
+	latest := genorm.Derive[derivedTestLatest](genorm.
+		Find(&preloadTestMessage{}, genorm.Tuple2(preloadTestMessageUserID, preloadTestMessageID)).
+		Where(genorm.Eq(preloadTestMessageUserID, genorm.Outer[*preloadTestMessage](aliasTestUserID))).
+		OrderBy(genorm.Desc, preloadTestMessageID).
+		Limit(3))
+
+	tests := []struct {
+		description string
+		joinedTable func(*derivedTestPair) *derivedTestPairTable
+		query       string
+	}{
+		{
+			description: "cross lateral join",
+			joinedTable: func(pair *derivedTestPair) *derivedTestPairTable {
+				return pair.CrossLateralJoin()
+			},
+			query: "SELECT users.id AS users_id_0, latest.value2 AS latest_value2_0 FROM (users CROSS JOIN LATERAL (SELECT messages.user_id AS value1, messages.id AS value2 FROM messages WHERE (messages.user_id = users.id) ORDER BY messages.id DESC LIMIT 3) AS latest)",
+		},
+		{
+			description: "left lateral join",
+			joinedTable: func(pair *derivedTestPair) *derivedTestPairTable {
+				return pair.LeftLateralJoin(genorm.Eq(
+					relation.LeftExpr(pair, aliasTestUserID),
+					relation.RightExpr(pair, genorm.DerivedTuple2(latest).Value1),
+				))
+			},
+			query: "SELECT users.id AS users_id_0, latest.value2 AS latest_value2_0 FROM (users LEFT JOIN LATERAL (SELECT messages.user_id AS value1, messages.id AS value2 FROM messages WHERE (messages.user_id = users.id) ORDER BY messages.id DESC LIMIT 3) AS latest ON (users.id = latest.value1))",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			pair := relation.Pair(&aliasTestUser{}, latest)
+
+			_, query, args, err := genorm.
+				Select(test.joinedTable(pair)).
+				Fields(
+					relation.Left(pair, aliasTestUserID),
+					relation.Right(pair, genorm.DerivedTuple2(latest).Value2),
+				).
+				BuildQuery()
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+			assert.Empty(t, args)
+		})
+	}
+}
+
+func TestLateralJoinNotSubquery(t *testing.T) {
+	t.Parallel()
+
+	joinedTable := relation.Pair(&aliasTestUser{}, &preloadTestMessage{}).CrossLateralJoin()
+
+	assert.NotEmpty(t, joinedTable.GetErrors())
+}
+
+func TestOuter(t *testing.T) {
+	t.Parallel()
+
+	derive := func(outer genorm.TypedTableExpr[*preloadTestMessage, genorm.WrappedPrimitive[int64]]) *genorm.DerivedTable[derivedTestLatest, *derivedTestTuple, derivedTestTuple] {
+		return genorm.Derive[derivedTestLatest](genorm.
+			Find(&preloadTestMessage{}, genorm.Tuple2(preloadTestMessageUserID, preloadTestMessageID)).
+			Where(genorm.Eq(preloadTestMessageUserID, outer)))
+	}
+
+	tests := []struct {
+		description string
+		joinedTable func() *derivedTestPairTable
+		query       string
+		isError     bool
+	}{
+		{
+			description: "column of the base table",
+			joinedTable: func() *derivedTestPairTable {
+				return relation.Pair(&aliasTestUser{}, derive(genorm.Outer[*preloadTestMessage](aliasTestUserID))).CrossLateralJoin()
+			},
+			query: "SELECT users.id AS users_id_0 FROM (users CROSS JOIN LATERAL (SELECT messages.user_id AS value1, messages.id AS value2 FROM messages WHERE (messages.user_id = users.id)) AS latest)",
+		},
+		{
+			description: "column of another table",
+			joinedTable: func() *derivedTestPairTable {
+				return relation.Pair(&aliasTestUser{}, derive(genorm.Outer[*preloadTestMessage](preloadTestMessageID))).CrossLateralJoin()
+			},
+			isError: true,
+		},
+		{
+			description: "without LATERAL",
+			joinedTable: func() *derivedTestPairTable {
+				return relation.Pair(&aliasTestUser{}, derive(genorm.Outer[*preloadTestMessage](aliasTestUserID))).CrossJoin()
+			},
+			isError: true,
+		},
+		{
+			description: "nil column",
+			joinedTable: func() *derivedTestPairTable {
+				return relation.Pair(&aliasTestUser{}, derive(genorm.Outer[*preloadTestMessage, *aliasTestUser, genorm.WrappedPrimitive[int64]](nil))).CrossLateralJoin()
+			},
+			isError: true,
+		},
+		{
+			description: "literal ? before outer column",
+			joinedTable: func() *derivedTestPairTable {
+				return relation.Pair(&aliasTestUser{}, genorm.Derive[derivedTestLatest](genorm.
+					Find(&preloadTestMessage{}, genorm.Tuple2(preloadTestMessageUserID, preloadTestMessageID)).
+					Where(genorm.RawExpr[*preloadTestMessage, genorm.WrappedPrimitive[bool]]("(messages.content <> '?')")).
+					Where(genorm.Eq(preloadTestMessageUserID, genorm.Outer[*preloadTestMessage](aliasTestUserID))))).CrossLateralJoin()
+			},
+			isError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			_, query, args, err := genorm.
+				Select(test.joinedTable()).
+				Fields(relation.Left((*derivedTestPair)(nil), aliasTestUserID)).
+				BuildQuery()
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+			assert.Empty(t, args)
+		})
+	}
+}
+
+func TestOuterWithoutSubquery(t *testing.T) {
+	t.Parallel()
+
+	_, args, err := genorm.
+		Pluck(&preloadTestMessage{}, preloadTestMessageID).
+		Where(genorm.Eq(preloadTestMessageUserID, genorm.Outer[*preloadTestMessage](aliasTestUserID))).
+		BuildQuery()
+	if !assert.NoError(t, err) || !assert.Len(t, args, 1) {
+		return
+	}
+
+	_, err = args[0].Value()
+	assert.Error(t, err)
+}
@@ -0,0 +1,81 @@
+package genorm
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+// Dialect SQL dialect used to render dialect-dependent expressions
+type Dialect uint8
+
+const (
+	MySQL Dialect = iota + 1
+	PostgreSQL
+)
+
+func (d Dialect) validate() error {
+	switch d {
+	case MySQL, PostgreSQL:
+		return nil
+	}
+
+	return fmt.Errorf("invalid dialect: %d", d)
+}
+
+/*
+dialectExpr
+expression rendered with the dialect of the query builder.
+It is embedded in the query as a placeholder and rendered by resolveDialectExprs.
+*/
+type dialectExpr struct {
+	render func(Dialect) (string, []ExprType, error)
+}
+
+func (de *dialectExpr) Value() (driver.Value, error) {
+	return nil, errors.New("dialect expression is not resolved")
+}
+
+func newDialectExpr[T Table, S ExprType](render func(Dialect) (string, []ExprType, error)) *ExprStruct[T, S] {
+	return &ExprStruct[T, S]{
+		query: "?",
+		args:  []ExprType{&dialectExpr{render: render}},
+	}
+}
+
+/*
+resolveDialectExprs
+replace the placeholders of the dialect expressions in args with the expressions rendered with dialect.
+The placeholders are matched to args by position(see resolvePlaceholders).
+*/
+func resolveDialectExprs(dialect Dialect, query string, args []ExprType) (string, []ExprType, error) {
+	hasDialectExpr := false
+	for _, arg := range args {
+		if _, ok := arg.(*dialectExpr); ok {
+			hasDialectExpr = true
+			break
+		}
+	}
+	if !hasDialectExpr {
+		return query, args, nil
+	}
+
+	return resolvePlaceholders(query, args, func(arg ExprType) (string, []ExprType, bool, error) {
+		de, ok := arg.(*dialectExpr)
+		if !ok {
+			return "", nil, false, nil
+		}
+
+		exprQuery, exprArgs, err := de.render(dialect)
+		if err != nil {
+			return "", nil, false, err
+		}
+
+		exprQuery, exprArgs, err = resolveDialectExprs(dialect, exprQuery, exprArgs)
+		if err != nil {
+			return "", nil, false, err
+		}
+
+		return exprQuery, exprArgs, true, nil
+	})
+}
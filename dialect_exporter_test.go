@@ -0,0 +1,5 @@
+package genorm
+
+func ResolveDialectExprs(dialect Dialect, query string, args []ExprType) (string, []ExprType, error) {
+	return resolveDialectExprs(dialect, query, args)
+}
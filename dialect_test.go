@@ -0,0 +1,116 @@
+package genorm_test
+
+import (
+	"testing"
+
+	"github.com/mazrean/genorm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDialectExprBuildQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		dialect     genorm.Dialect
+		query       string
+		args        []genorm.ExprType
+	}{
+		{
+			description: "mysql",
+			dialect:     genorm.MySQL,
+			query:       "SELECT EXTRACT(YEAR FROM DATE_ADD(NOW(), INTERVAL ? DAY)) AS res FROM messages WHERE (messages.id = ?)",
+			args:        []genorm.ExprType{genorm.Wrap(int64(1)), genorm.Wrap(int64(2))},
+		},
+		{
+			description: "postgres",
+			dialect:     genorm.PostgreSQL,
+			query:       "SELECT CAST(EXTRACT(YEAR FROM (NOW() + ? * INTERVAL '1 DAY')) AS BIGINT) AS res FROM messages WHERE (messages.id = ?)",
+			args:        []genorm.ExprType{genorm.Wrap(int64(1)), genorm.Wrap(int64(2))},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			query, args, err := genorm.
+				Pluck(&preloadTestMessage{}, genorm.Extract(genorm.Year, genorm.DateAdd(
+					genorm.Now[*preloadTestMessage](),
+					genorm.Interval{Value: 1, Unit: genorm.Day},
+				))).
+				Where(genorm.EqLit(preloadTestMessageID, genorm.Wrap(int64(2)))).
+				Dialect(test.dialect).
+				BuildQuery()
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+			assert.Equal(t, test.args, args)
+		})
+	}
+}
+
+func TestResolveDialectExprs(t *testing.T) {
+	t.Parallel()
+
+	dateAddQuery, dateAddArgs, errs := genorm.DateAdd(
+		genorm.Now[*preloadTestMessage](),
+		genorm.Interval{Value: 1, Unit: genorm.Day},
+	).Expr()
+	if !assert.Nil(t, errs) {
+		return
+	}
+
+	tests := []struct {
+		description  string
+		query        string
+		args         []genorm.ExprType
+		expected     string
+		expectedArgs []genorm.ExprType
+		isError      bool
+	}{
+		{
+			description:  "dialect expr",
+			query:        "(messages.id = ?) AND (messages.created_at < " + dateAddQuery + ")",
+			args:         append([]genorm.ExprType{genorm.Wrap(int64(1))}, dateAddArgs...),
+			expected:     "(messages.id = ?) AND (messages.created_at < DATE_ADD(NOW(), INTERVAL ? DAY))",
+			expectedArgs: []genorm.ExprType{genorm.Wrap(int64(1)), genorm.Wrap(int64(1))},
+		},
+		{
+			description:  "no dialect expr",
+			query:        "(messages.id = ?)",
+			args:         []genorm.ExprType{genorm.Wrap(int64(1))},
+			expected:     "(messages.id = ?)",
+			expectedArgs: []genorm.ExprType{genorm.Wrap(int64(1))},
+		},
+		{
+			description: "literal ? before dialect expr",
+			query:       "CONCAT('?', " + dateAddQuery + ")",
+			args:        dateAddArgs,
+			isError:     true,
+		},
+		{
+			description: "placeholders fewer than arguments",
+			query:       "(messages.id = 1)",
+			args:        dateAddArgs,
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			query, args, err := genorm.ResolveDialectExprs(genorm.MySQL, test.query, test.args)
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.expected, query)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
@@ -1,5 +1,11 @@
 package genorm
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
 type Expr interface {
 	Expr() (string, []ExprType, []error)
 }
@@ -21,9 +27,11 @@ type TypedTableExpr[T Table, S ExprType] interface {
 }
 
 type TableAssignExpr[T Table] struct {
-	query string
-	args  []ExprType
-	errs  []error
+	query      string
+	column     Column
+	valueQuery string
+	args       []ExprType
+	errs       []error
 }
 
 func (tae *TableAssignExpr[_]) AssignExpr() (string, []ExprType, []error) {
@@ -34,6 +42,15 @@ func (tae *TableAssignExpr[_]) AssignExpr() (string, []ExprType, []error) {
 	return tae.query, tae.args, nil
 }
 
+// unqualifiedAssignExpr column_name = expr(PostgreSQL does not allow table_name.column_name in SET)
+func (tae *TableAssignExpr[_]) unqualifiedAssignExpr() (string, []ExprType, []error) {
+	if len(tae.errs) != 0 {
+		return "", nil, tae.errs
+	}
+
+	return fmt.Sprintf("%s = %s", tae.column.ColumnName(), tae.valueQuery), tae.args, nil
+}
+
 type ExprStruct[T Table, S ExprType] struct {
 	query string
 	args  []ExprType
@@ -62,3 +79,53 @@ func (es *ExprStruct[T, _]) TableExpr(T) (string, []ExprType, []error) {
 func (es *ExprStruct[_, S]) TypedExpr(S) (string, []ExprType, []error) {
 	return es.Expr()
 }
+
+/*
+resolvePlaceholders
+replace the placeholders of args with the expressions returned by resolve.
+If resolve returns ok=false, the placeholder and the argument are kept.
+The placeholders are matched to args by position,
+so the SQL text rendered by genorm must not contain a literal "?".
+*/
+func resolvePlaceholders(query string, args []ExprType, resolve func(ExprType) (string, []ExprType, bool, error)) (string, []ExprType, error) {
+	sb := strings.Builder{}
+	newArgs := make([]ExprType, 0, len(args))
+	for _, arg := range args {
+		i := strings.IndexByte(query, '?')
+		if i < 0 {
+			return "", nil, errors.New("placeholders are fewer than arguments")
+		}
+
+		_, err := sb.WriteString(query[:i])
+		if err != nil {
+			return "", nil, fmt.Errorf("write string(%s): %w", query[:i], err)
+		}
+		query = query[i+1:]
+
+		exprQuery, exprArgs, ok, err := resolve(arg)
+		if err != nil {
+			return "", nil, err
+		}
+		if !ok {
+			exprQuery, exprArgs = "?", []ExprType{arg}
+		}
+
+		_, err = sb.WriteString(exprQuery)
+		if err != nil {
+			return "", nil, fmt.Errorf("write string(%s): %w", exprQuery, err)
+		}
+
+		newArgs = append(newArgs, exprArgs...)
+	}
+
+	if strings.IndexByte(query, '?') >= 0 {
+		return "", nil, errors.New("placeholders are more than arguments")
+	}
+
+	_, err := sb.WriteString(query)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", query, err)
+	}
+
+	return sb.String(), newArgs, nil
+}
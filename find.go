@@ -3,6 +3,7 @@ package genorm
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"strings"
@@ -19,6 +20,8 @@ type FindContext[S Table, T TuplePointer[U], U any] struct {
 	limit           limitClause
 	offset          offsetClause
 	lockType        lockClause
+	hint            hintClause
+	keyset          keysetClause[S]
 }
 
 func Find[T Table, S TuplePointer[U], U any](table T, tuple S) *FindContext[T, S, U] {
@@ -28,7 +31,16 @@ func Find[T Table, S TuplePointer[U], U any](table T, tuple S) *FindContext[T, S
 	}
 }
 
+func (c *FindContext[S, T, U]) clone() *FindContext[S, T, U] {
+	clone := *c
+	clone.Context = c.Context.clone()
+
+	return &clone
+}
+
 func (c *FindContext[S, T, U]) Distinct() *FindContext[S, T, U] {
+	c = c.clone()
+
 	if c.distinct {
 		c.addError(errors.New("distinct already set"))
 		return c
@@ -42,6 +54,8 @@ func (c *FindContext[S, T, U]) Distinct() *FindContext[S, T, U] {
 func (c *FindContext[S, T, U]) Where(
 	condition TypedTableExpr[S, WrappedPrimitive[bool]],
 ) *FindContext[S, T, U] {
+	c = c.clone()
+
 	err := c.whereCondition.set(condition)
 	if err != nil {
 		c.addError(fmt.Errorf("where condition: %w", err))
@@ -50,7 +64,22 @@ func (c *FindContext[S, T, U]) Where(
 	return c
 }
 
+func (c *FindContext[S, T, U]) OrWhere(
+	condition TypedTableExpr[S, WrappedPrimitive[bool]],
+) *FindContext[S, T, U] {
+	c = c.clone()
+
+	err := c.whereCondition.or(condition)
+	if err != nil {
+		c.addError(fmt.Errorf("where condition: %w", err))
+	}
+
+	return c
+}
+
 func (c *FindContext[S, T, U]) GroupBy(exprs ...TableExpr[S]) *FindContext[S, T, U] {
+	c = c.clone()
+
 	err := c.groupExpr.set(exprs)
 	if err != nil {
 		c.addError(fmt.Errorf("group by: %w", err))
@@ -62,6 +91,8 @@ func (c *FindContext[S, T, U]) GroupBy(exprs ...TableExpr[S]) *FindContext[S, T,
 func (c *FindContext[S, T, U]) Having(
 	condition TypedTableExpr[S, WrappedPrimitive[bool]],
 ) *FindContext[S, T, U] {
+	c = c.clone()
+
 	err := c.havingCondition.set(condition)
 	if err != nil {
 		c.addError(fmt.Errorf("having condition: %w", err))
@@ -71,6 +102,8 @@ func (c *FindContext[S, T, U]) Having(
 }
 
 func (c *FindContext[S, T, U]) OrderBy(direction OrderDirection, expr TableExpr[S]) *FindContext[S, T, U] {
+	c = c.clone()
+
 	err := c.order.add(orderItem[S]{
 		expr:      expr,
 		direction: direction,
@@ -83,6 +116,8 @@ func (c *FindContext[S, T, U]) OrderBy(direction OrderDirection, expr TableExpr[
 }
 
 func (c *FindContext[S, T, U]) Limit(limit uint64) *FindContext[S, T, U] {
+	c = c.clone()
+
 	err := c.limit.set(limit)
 	if err != nil {
 		c.addError(fmt.Errorf("limit: %w", err))
@@ -92,6 +127,8 @@ func (c *FindContext[S, T, U]) Limit(limit uint64) *FindContext[S, T, U] {
 }
 
 func (c *FindContext[S, T, U]) Offset(offset uint64) *FindContext[S, T, U] {
+	c = c.clone()
+
 	err := c.offset.set(offset)
 	if err != nil {
 		c.addError(fmt.Errorf("offset: %w", err))
@@ -100,8 +137,10 @@ func (c *FindContext[S, T, U]) Offset(offset uint64) *FindContext[S, T, U] {
 	return c
 }
 
-func (c *FindContext[S, T, U]) Lock(lockType LockType) *FindContext[S, T, U] {
-	err := c.lockType.set(lockType)
+func (c *FindContext[S, T, U]) Lock(lockType LockType, options ...LockOption) *FindContext[S, T, U] {
+	c = c.clone()
+
+	err := c.lockType.set(lockType, options...)
 	if err != nil {
 		c.addError(fmt.Errorf("lock: %w", err))
 	}
@@ -109,6 +148,62 @@ func (c *FindContext[S, T, U]) Lock(lockType LockType) *FindContext[S, T, U] {
 	return c
 }
 
+// IndexHint index hint for table in the FROM clause(MySQL only)
+func (c *FindContext[S, T, U]) IndexHint(table BasicTable, hintType IndexHintType, indexes ...string) *FindContext[S, T, U] {
+	c = c.clone()
+
+	err := c.hint.addIndexHint(table, hintType, indexes)
+	if err != nil {
+		c.addError(fmt.Errorf("index hint: %w", err))
+	}
+
+	return c
+}
+
+// OptimizerHint optimizer hint comment(/*+ hint */) after SELECT(MySQL only)
+func (c *FindContext[S, T, U]) OptimizerHint(hint string) *FindContext[S, T, U] {
+	c = c.clone()
+
+	err := c.hint.addOptimizerHint(hint)
+	if err != nil {
+		c.addError(fmt.Errorf("optimizer hint: %w", err))
+	}
+
+	return c
+}
+
+func (c *FindContext[S, T, U]) Dialect(dialect Dialect) *FindContext[S, T, U] {
+	c = c.clone()
+
+	c.setDialect(dialect)
+
+	return c
+}
+
+// Paginate keyset pagination ordered by columns. Columns must identify a row uniquely and be selected in the tuple.
+func (c *FindContext[S, T, U]) Paginate(size uint64, direction OrderDirection, columns ...TableColumns[S]) *FindContext[S, T, U] {
+	c = c.clone()
+
+	err := c.keyset.set(size, direction, columns)
+	if err != nil {
+		c.addError(fmt.Errorf("paginate: %w", err))
+	}
+
+	return c
+}
+
+// After fetch the page after cursor. Empty cursor means the first page.
+func (c *FindContext[S, T, U]) After(cursor Cursor) *FindContext[S, T, U] {
+	c = c.clone()
+
+	err := c.keyset.setCursor(cursor)
+	if err != nil {
+		c.addError(fmt.Errorf("after: %w", err))
+	}
+
+	return c
+}
+
 func (c *FindContext[S, T, U]) GetAllCtx(ctx context.Context, db DB) ([]T, error) {
 	errs := c.Errors()
 	if len(errs) != 0 {
@@ -158,7 +253,120 @@ func (c *FindContext[S, T, U]) GetAll(db DB) ([]T, error) {
 	return c.GetAllCtx(context.Background(), db)
 }
 
+// GetCursorPageCtx fetch a page of Paginate. Cursor is empty if the next page does not exist.
+func (c *FindContext[S, T, U]) GetCursorPageCtx(ctx context.Context, db DB) ([]T, Cursor, error) {
+	if len(c.keyset.columns) == 0 {
+		return nil, "", errors.New("paginate not set")
+	}
+
+	errs := c.Errors()
+	if len(errs) != 0 {
+		return nil, "", errs[0]
+	}
+
+	query, exprArgs, err := c.buildQuery()
+	if err != nil {
+		return nil, "", fmt.Errorf("build query: %w", err)
+	}
+
+	keysetPositions, err := c.keysetPositions()
+	if err != nil {
+		return nil, "", err
+	}
+
+	args := make([]any, 0, len(exprArgs))
+	for _, arg := range exprArgs {
+		args = append(args, arg)
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return []T{}, "", nil
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	exprs := []T{}
+	for rows.Next() {
+		if uint64(len(exprs)) == c.keyset.size {
+			// the extra row only tells that the next page exists
+			cursor, err := nextTupleCursor(exprs[len(exprs)-1], keysetPositions)
+			if err != nil {
+				return nil, "", fmt.Errorf("cursor: %w", err)
+			}
+
+			return exprs, cursor, nil
+		}
+
+		var tuple U
+		columns := T(&tuple).Columns()
+		dests := make([]any, 0, len(columns))
+		for _, column := range columns {
+			dests = append(dests, column)
+		}
+
+		err = rows.Scan(dests...)
+		if err != nil {
+			return nil, "", fmt.Errorf("scan: %w", err)
+		}
+
+		exprs = append(exprs, &tuple)
+	}
+
+	return exprs, "", nil
+}
+
+// keysetPositions positions of the keyset columns in the tuple. keyset columns must be selected.
+func (c *FindContext[S, T, U]) keysetPositions() ([]int, error) {
+	fieldPositions := map[string]int{}
+	for i, field := range c.tuple.Exprs() {
+		fieldQuery, _, errs := field.Expr()
+		if len(errs) != 0 {
+			return nil, fmt.Errorf("field: %w", errs[0])
+		}
+
+		if _, ok := fieldPositions[fieldQuery]; !ok {
+			fieldPositions[fieldQuery] = i
+		}
+	}
+
+	positions := make([]int, 0, len(c.keyset.columns))
+	for _, column := range c.keyset.columns {
+		position, ok := fieldPositions[column.SQLColumnName()]
+		if !ok {
+			return nil, fmt.Errorf("keyset column %s is not selected", column.SQLColumnName())
+		}
+
+		positions = append(positions, position)
+	}
+
+	return positions, nil
+}
+
+func nextTupleCursor[T Tuple](tuple T, keysetPositions []int) (Cursor, error) {
+	columns := tuple.Columns()
+
+	values := make([]driver.Value, 0, len(keysetPositions))
+	for _, position := range keysetPositions {
+		value, err := columns[position].Value()
+		if err != nil {
+			return "", fmt.Errorf("value%d: %w", position, err)
+		}
+
+		values = append(values, value)
+	}
+
+	return encodeCursor(values)
+}
+
+func (c *FindContext[S, T, U]) GetCursorPage(db DB) ([]T, Cursor, error) {
+	return c.GetCursorPageCtx(context.Background(), db)
+}
+
 func (c *FindContext[S, T, U]) GetCtx(ctx context.Context, db DB) (T, error) {
+	c = c.clone()
 	err := c.limit.set(1)
 	if err != nil {
 		return nil, fmt.Errorf("set limit 1: %w", err)
@@ -204,6 +412,21 @@ func (c *FindContext[S, T, U]) Get(db DB) (T, error) {
 }
 
 func (c *FindContext[S, T, U]) buildQuery() (string, []ExprType, error) {
+	return c.buildAliasedQuery(func(position int) string {
+		return fmt.Sprintf("value%d", position)
+	})
+}
+
+// buildAliasedQuery the query whose columns are named by columnAlias(position: 0-based)
+func (c *FindContext[S, T, U]) buildAliasedQuery(columnAlias func(position int) string) (string, []ExprType, error) {
+	whereCondition, order, limit := c.whereCondition, c.order, c.limit
+	if c.keyset.exists() {
+		err := c.keyset.apply(c.dialect, &whereCondition, &order, &limit, &c.offset)
+		if err != nil {
+			return "", nil, fmt.Errorf("paginate: %w", err)
+		}
+	}
+
 	sb := strings.Builder{}
 	args := []ExprType{}
 
@@ -213,6 +436,17 @@ func (c *FindContext[S, T, U]) buildQuery() (string, []ExprType, error) {
 		return "", nil, fmt.Errorf("write select(%s): %w", str, err)
 	}
 
+	err = c.hint.validate(c.dialect)
+	if err != nil {
+		return "", nil, fmt.Errorf("hint: %w", err)
+	}
+
+	str = c.hint.getOptimizerHintExpr()
+	_, err = sb.WriteString(str)
+	if err != nil {
+		return "", nil, fmt.Errorf("write hint(%s): %w", str, err)
+	}
+
 	if c.distinct {
 		str = "DISTINCT "
 		_, err = sb.WriteString(str)
@@ -241,7 +475,7 @@ func (c *FindContext[S, T, U]) buildQuery() (string, []ExprType, error) {
 			return "", nil, fmt.Errorf("write field(%s): %w", fieldQuery, err)
 		}
 
-		str = fmt.Sprintf(" AS value%d", i)
+		str = " AS " + columnAlias(i)
 		_, err = sb.WriteString(str)
 		if err != nil {
 			return "", nil, fmt.Errorf("write as(%s): %w", str, err)
@@ -256,9 +490,19 @@ func (c *FindContext[S, T, U]) buildQuery() (string, []ExprType, error) {
 		return "", nil, fmt.Errorf("write from(%s): %w", str, err)
 	}
 
-	tableQuery, tableArgs, errs := c.table.Expr()
-	if len(errs) != 0 {
-		return "", nil, fmt.Errorf("table expr: %w", errs[0])
+	indexHints, err := c.hint.newIndexHints()
+	if err != nil {
+		return "", nil, fmt.Errorf("index hint: %w", err)
+	}
+
+	tableQuery, tableArgs, err := c.indexHintTableExpr(indexHints)
+	if err != nil {
+		return "", nil, fmt.Errorf("table expr: %w", err)
+	}
+
+	err = indexHints.validate()
+	if err != nil {
+		return "", nil, fmt.Errorf("index hint: %w", err)
 	}
 
 	_, err = sb.WriteString(tableQuery)
@@ -268,8 +512,8 @@ func (c *FindContext[S, T, U]) buildQuery() (string, []ExprType, error) {
 
 	args = append(args, tableArgs...)
 
-	if c.whereCondition.exists() {
-		whereQuery, whereArgs, err := c.whereCondition.getExpr()
+	if whereCondition.exists() {
+		whereQuery, whereArgs, err := whereCondition.getExpr()
 		if err != nil {
 			return "", nil, fmt.Errorf("where condition: %w", err)
 		}
@@ -328,8 +572,8 @@ func (c *FindContext[S, T, U]) buildQuery() (string, []ExprType, error) {
 		args = append(args, havingArgs...)
 	}
 
-	if c.order.exists() {
-		orderQuery, orderArgs, err := c.order.getExpr()
+	if order.exists() {
+		orderQuery, orderArgs, err := order.getExpr()
 		if err != nil {
 			return "", nil, fmt.Errorf("order: %w", err)
 		}
@@ -348,8 +592,8 @@ func (c *FindContext[S, T, U]) buildQuery() (string, []ExprType, error) {
 		args = append(args, orderArgs...)
 	}
 
-	if c.limit.exists() {
-		limitQuery, limitArgs, err := c.limit.getExpr()
+	if limit.exists() {
+		limitQuery, limitArgs, err := limit.getExpr()
 		if err != nil {
 			return "", nil, fmt.Errorf("limit: %w", err)
 		}
@@ -389,6 +633,11 @@ func (c *FindContext[S, T, U]) buildQuery() (string, []ExprType, error) {
 	}
 
 	if c.lockType.exists() {
+		err = c.lockType.validate(c.dialect, c.table)
+		if err != nil {
+			return "", nil, fmt.Errorf("lock type: %w", err)
+		}
+
 		lockQuery, lockArgs, err := c.lockType.getExpr()
 		if err != nil {
 			return "", nil, fmt.Errorf("lock type: %w", err)
@@ -408,5 +657,5 @@ func (c *FindContext[S, T, U]) buildQuery() (string, []ExprType, error) {
 		args = append(args, lockArgs...)
 	}
 
-	return sb.String(), args, nil
+	return c.resolveDialectExprs(sb.String(), args)
 }
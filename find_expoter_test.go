@@ -3,3 +3,7 @@ package genorm
 func (c *FindContext[_, _, _]) BuildQuery() (string, []ExprType, error) {
 	return c.buildQuery()
 }
+
+func (c *FindContext[_, _, _]) KeysetPositions() ([]int, error) {
+	return c.keysetPositions()
+}
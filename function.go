@@ -3,6 +3,8 @@ package genorm
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 )
 
 // Aggregate Functions
@@ -69,6 +71,93 @@ func Count[T Table, S ExprType](expr TypedTableExpr[T, S], distinct bool) TypedT
 	}
 }
 
+// CountAll COUNT(*)
+func CountAll[T Table]() TypedTableExpr[T, WrappedPrimitive[int64]] {
+	return &ExprStruct[T, WrappedPrimitive[int64]]{
+		query: "COUNT(*)",
+	}
+}
+
+/*
+	Sum
+	if (distinct) {return SUM(DISTINCT expr)}
+	else {return SUM(expr)}
+	SUM of integer columns is returned as int64(MySQL returns DECIMAL, which is scanned as int64).
+*/
+func Sum[T Table, S ExprIntegerPrimitive](expr TypedTableExpr[T, WrappedPrimitive[S]], distinct bool) TypedTableExpr[T, WrappedPrimitive[int64]] {
+	return aggregate[T, WrappedPrimitive[S], WrappedPrimitive[int64]]("SUM", expr, distinct)
+}
+
+/*
+	SumFloat
+	if (distinct) {return SUM(DISTINCT expr)}
+	else {return SUM(expr)}
+*/
+func SumFloat[T Table, S ExprFloatPrimitive](expr TypedTableExpr[T, WrappedPrimitive[S]], distinct bool) TypedTableExpr[T, WrappedPrimitive[float64]] {
+	return aggregate[T, WrappedPrimitive[S], WrappedPrimitive[float64]]("SUM", expr, distinct)
+}
+
+// BitAnd BIT_AND(expr)
+func BitAnd[T Table, S ExprIntegerPrimitive](expr TypedTableExpr[T, WrappedPrimitive[S]]) TypedTableExpr[T, WrappedPrimitive[S]] {
+	return aggregate[T, WrappedPrimitive[S], WrappedPrimitive[S]]("BIT_AND", expr, false)
+}
+
+// BitOr BIT_OR(expr)
+func BitOr[T Table, S ExprIntegerPrimitive](expr TypedTableExpr[T, WrappedPrimitive[S]]) TypedTableExpr[T, WrappedPrimitive[S]] {
+	return aggregate[T, WrappedPrimitive[S], WrappedPrimitive[S]]("BIT_OR", expr, false)
+}
+
+// StddevPop STDDEV_POP(expr)
+func StddevPop[T Table, S ExprNumericPrimitive](expr TypedTableExpr[T, WrappedPrimitive[S]]) TypedTableExpr[T, WrappedPrimitive[float64]] {
+	return aggregate[T, WrappedPrimitive[S], WrappedPrimitive[float64]]("STDDEV_POP", expr, false)
+}
+
+// StddevSamp STDDEV_SAMP(expr)
+func StddevSamp[T Table, S ExprNumericPrimitive](expr TypedTableExpr[T, WrappedPrimitive[S]]) TypedTableExpr[T, WrappedPrimitive[float64]] {
+	return aggregate[T, WrappedPrimitive[S], WrappedPrimitive[float64]]("STDDEV_SAMP", expr, false)
+}
+
+// VarPop VAR_POP(expr)
+func VarPop[T Table, S ExprNumericPrimitive](expr TypedTableExpr[T, WrappedPrimitive[S]]) TypedTableExpr[T, WrappedPrimitive[float64]] {
+	return aggregate[T, WrappedPrimitive[S], WrappedPrimitive[float64]]("VAR_POP", expr, false)
+}
+
+// VarSamp VAR_SAMP(expr)
+func VarSamp[T Table, S ExprNumericPrimitive](expr TypedTableExpr[T, WrappedPrimitive[S]]) TypedTableExpr[T, WrappedPrimitive[float64]] {
+	return aggregate[T, WrappedPrimitive[S], WrappedPrimitive[float64]]("VAR_SAMP", expr, false)
+}
+
+// JSONArrayAgg JSON_ARRAYAGG(expr)
+func JSONArrayAgg[T Table, S ExprType](expr TypedTableExpr[T, S]) TypedTableExpr[T, WrappedPrimitive[string]] {
+	return aggregate[T, S, WrappedPrimitive[string]]("JSON_ARRAYAGG", expr, false)
+}
+
+func aggregate[T Table, S ExprType, U ExprType](funcName string, expr TypedTableExpr[T, S], distinct bool) TypedTableExpr[T, U] {
+	if expr == nil {
+		return &ExprStruct[T, U]{
+			errs: []error{fmt.Errorf("%s expr is nil", strings.ToLower(funcName))},
+		}
+	}
+
+	query, args, errs := expr.Expr()
+	if len(errs) != 0 {
+		return &ExprStruct[T, U]{
+			errs: errs,
+		}
+	}
+
+	if distinct {
+		query = fmt.Sprintf("%s(DISTINCT %s)", funcName, query)
+	} else {
+		query = fmt.Sprintf("%s(%s)", funcName, query)
+	}
+
+	return &ExprStruct[T, U]{
+		query: query,
+		args:  args,
+	}
+}
+
 // Max MAX(expr)
 func Max[T Table, S ExprType](expr TypedTableExpr[T, S]) TypedTableExpr[T, S] {
 	if expr == nil {
@@ -110,3 +199,159 @@ func Min[T Table, S ExprType](expr TypedTableExpr[T, S]) TypedTableExpr[T, S] {
 		args:  args,
 	}
 }
+
+type stringAggregateType uint8
+
+const (
+	groupConcat stringAggregateType = iota + 1
+	stringAgg
+)
+
+// StringAggregateExpr string aggregation(GROUP_CONCAT, STRING_AGG)
+type StringAggregateExpr[T Table] struct {
+	aggregateType stringAggregateType
+	expr          Expr
+	distinct      bool
+	order         orderClause[T]
+	separator     string
+	errs          []error
+}
+
+/*
+	GroupConcat(MySQL)
+	if (distinct) {return GROUP_CONCAT(DISTINCT expr ORDER BY ... SEPARATOR separator)}
+	else {return GROUP_CONCAT(expr ORDER BY ... SEPARATOR separator)}
+*/
+func GroupConcat[T Table, S ExprType](expr TypedTableExpr[T, S], distinct bool) *StringAggregateExpr[T] {
+	return newStringAggregateExpr[T](groupConcat, expr, distinct)
+}
+
+/*
+	StringAgg(PostgreSQL)
+	if (distinct) {return STRING_AGG(DISTINCT expr, separator ORDER BY ...)}
+	else {return STRING_AGG(expr, separator ORDER BY ...)}
+*/
+func StringAgg[T Table, S ExprType](expr TypedTableExpr[T, S], distinct bool) *StringAggregateExpr[T] {
+	return newStringAggregateExpr[T](stringAgg, expr, distinct)
+}
+
+func newStringAggregateExpr[T Table](aggregateType stringAggregateType, expr Expr, distinct bool) *StringAggregateExpr[T] {
+	sae := &StringAggregateExpr[T]{
+		aggregateType: aggregateType,
+		expr:          expr,
+		distinct:      distinct,
+		separator:     ",",
+	}
+
+	if expr == nil {
+		sae.errs = append(sae.errs, errors.New("string aggregate expr is nil"))
+	}
+
+	return sae
+}
+
+func (sae *StringAggregateExpr[T]) clone() *StringAggregateExpr[T] {
+	clone := *sae
+	clone.errs = slices.Clone(sae.errs)
+
+	return &clone
+}
+
+// OrderBy ORDER BY in the aggregation
+func (sae *StringAggregateExpr[T]) OrderBy(direction OrderDirection, expr TableExpr[T]) *StringAggregateExpr[T] {
+	sae = sae.clone()
+
+	err := sae.order.add(orderItem[T]{
+		expr:      expr,
+		direction: direction,
+	})
+	if err != nil {
+		sae.errs = append(sae.errs, fmt.Errorf("order by: %w", err))
+	}
+
+	return sae
+}
+
+/*
+	Separator
+	SEPARATOR(default: ",")
+	GroupConcat renders the separator as a string literal with backslashes escaped,
+	which assumes that NO_BACKSLASH_ESCAPES is not set in sql_mode.
+	The literal must not contain "?", because "?" in the query is a placeholder.
+*/
+func (sae *StringAggregateExpr[T]) Separator(separator string) *StringAggregateExpr[T] {
+	sae = sae.clone()
+
+	if sae.aggregateType == groupConcat && strings.Contains(separator, "?") {
+		sae.errs = append(sae.errs, fmt.Errorf("separator of group concat contains ?: %s", separator))
+		return sae
+	}
+
+	sae.separator = separator
+
+	return sae
+}
+
+func (sae *StringAggregateExpr[_]) Expr() (string, []ExprType, []error) {
+	if len(sae.errs) != 0 {
+		return "", nil, sae.errs
+	}
+
+	query, args, errs := sae.expr.Expr()
+	if len(errs) != 0 {
+		return "", nil, errs
+	}
+
+	if sae.distinct {
+		query = "DISTINCT " + query
+	}
+
+	var (
+		orderQuery string
+		orderArgs  []ExprType
+	)
+	if sae.order.exists() {
+		var err error
+		orderQuery, orderArgs, err = sae.order.getExpr()
+		if err != nil {
+			return "", nil, []error{fmt.Errorf("order by: %w", err)}
+		}
+
+		orderQuery = " " + orderQuery
+	}
+
+	switch sae.aggregateType {
+	case groupConcat:
+		// MySQL does not accept a placeholder in SEPARATOR
+		return fmt.Sprintf("GROUP_CONCAT(%s%s SEPARATOR %s)", query, orderQuery, quoteStringLiteral(sae.separator)),
+			append(args, orderArgs...),
+			nil
+	case stringAgg:
+		args = append(args, Wrap(sae.separator))
+		return fmt.Sprintf("STRING_AGG(%s, ?%s)", query, orderQuery),
+			append(args, orderArgs...),
+			nil
+	}
+
+	return "", nil, []error{errors.New("invalid string aggregate type")}
+}
+
+func (sae *StringAggregateExpr[T]) TableExpr(T) (string, []ExprType, []error) {
+	return sae.Expr()
+}
+
+func (sae *StringAggregateExpr[_]) TypedExpr(WrappedPrimitive[string]) (string, []ExprType, []error) {
+	return sae.Expr()
+}
+
+/*
+	quoteStringLiteral
+	quote string for places where a placeholder is not allowed(MySQL).
+	Backslashes are escaped for the default sql_mode, so the literal is wrong under NO_BACKSLASH_ESCAPES.
+*/
+func quoteStringLiteral(str string) string {
+	str = strings.ReplaceAll(str, `\`, `\\`)
+	str = strings.ReplaceAll(str, "'", "''")
+
+	return "'" + str + "'"
+}
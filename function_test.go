@@ -339,3 +339,486 @@ func TestMin(t *testing.T) {
 		})
 	}
 }
+
+func TestCountAll(t *testing.T) {
+	t.Parallel()
+
+	res := genorm.CountAll[*mock.MockTable]()
+
+	query, args, errs := res.Expr()
+	assert.Nil(t, errs)
+	assert.Equal(t, "COUNT(*)", query)
+	assert.Nil(t, args)
+}
+
+func TestSum(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description   string
+		exprIsNil     bool
+		exprQuery     string
+		exprArgs      []genorm.ExprType
+		exprErrs      []error
+		distinct      bool
+		expectedQuery string
+		expectedArgs  []genorm.ExprType
+		isError       bool
+	}{
+		{
+			description:   "normal",
+			exprQuery:     "hoge.huga",
+			expectedQuery: "SUM(hoge.huga)",
+		},
+		{
+			description:   "distinct",
+			exprQuery:     "hoge.huga",
+			distinct:      true,
+			expectedQuery: "SUM(DISTINCT hoge.huga)",
+		},
+		{
+			description:   "with args",
+			exprQuery:     "(hoge.huga + ?)",
+			exprArgs:      []genorm.ExprType{genorm.Wrap(int64(1))},
+			expectedQuery: "SUM((hoge.huga + ?))",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(int64(1))},
+		},
+		{
+			description: "nil expr",
+			exprIsNil:   true,
+			isError:     true,
+		},
+		{
+			description: "expr error",
+			exprErrs:    []error{errors.New("expr error")},
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int32]]
+			if !test.exprIsNil {
+				mockExpr := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[int32]](ctrl)
+				expr = mockExpr
+
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return(test.exprQuery, test.exprArgs, test.exprErrs)
+			}
+
+			var res genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]] = genorm.Sum(expr, test.distinct)
+
+			query, args, errs := res.Expr()
+			if test.isError {
+				assert.NotEmpty(t, errs)
+				return
+			}
+
+			if !assert.Nil(t, errs) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
+
+func TestSumFloat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description   string
+		exprIsNil     bool
+		exprQuery     string
+		exprErrs      []error
+		distinct      bool
+		expectedQuery string
+		isError       bool
+	}{
+		{
+			description:   "normal",
+			exprQuery:     "hoge.huga",
+			expectedQuery: "SUM(hoge.huga)",
+		},
+		{
+			description:   "distinct",
+			exprQuery:     "hoge.huga",
+			distinct:      true,
+			expectedQuery: "SUM(DISTINCT hoge.huga)",
+		},
+		{
+			description: "nil expr",
+			exprIsNil:   true,
+			isError:     true,
+		},
+		{
+			description: "expr error",
+			exprErrs:    []error{errors.New("expr error")},
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[float32]]
+			if !test.exprIsNil {
+				mockExpr := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[float32]](ctrl)
+				expr = mockExpr
+
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return(test.exprQuery, nil, test.exprErrs)
+			}
+
+			var res genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[float64]] = genorm.SumFloat(expr, test.distinct)
+
+			query, _, errs := res.Expr()
+			if test.isError {
+				assert.NotEmpty(t, errs)
+				return
+			}
+
+			if !assert.Nil(t, errs) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
+		})
+	}
+}
+
+func TestSingleArgAggregates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description   string
+		aggregate     func(genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr
+		exprIsNil     bool
+		exprErrs      []error
+		expectedQuery string
+		isError       bool
+	}{
+		{
+			description: "bit and",
+			aggregate: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr {
+				return genorm.BitAnd(expr)
+			},
+			expectedQuery: "BIT_AND(hoge.huga)",
+		},
+		{
+			description: "bit or",
+			aggregate: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr {
+				return genorm.BitOr(expr)
+			},
+			expectedQuery: "BIT_OR(hoge.huga)",
+		},
+		{
+			description: "stddev pop",
+			aggregate: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr {
+				return genorm.StddevPop(expr)
+			},
+			expectedQuery: "STDDEV_POP(hoge.huga)",
+		},
+		{
+			description: "stddev samp",
+			aggregate: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr {
+				return genorm.StddevSamp(expr)
+			},
+			expectedQuery: "STDDEV_SAMP(hoge.huga)",
+		},
+		{
+			description: "var pop",
+			aggregate: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr {
+				return genorm.VarPop(expr)
+			},
+			expectedQuery: "VAR_POP(hoge.huga)",
+		},
+		{
+			description: "var samp",
+			aggregate: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr {
+				return genorm.VarSamp(expr)
+			},
+			expectedQuery: "VAR_SAMP(hoge.huga)",
+		},
+		{
+			description: "json arrayagg",
+			aggregate: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr {
+				return genorm.JSONArrayAgg(expr)
+			},
+			expectedQuery: "JSON_ARRAYAGG(hoge.huga)",
+		},
+		{
+			description: "nil expr",
+			aggregate: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr {
+				return genorm.BitAnd(expr)
+			},
+			exprIsNil: true,
+			isError:   true,
+		},
+		{
+			description: "expr error",
+			aggregate: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]) genorm.Expr {
+				return genorm.StddevPop(expr)
+			},
+			exprErrs: []error{errors.New("expr error")},
+			isError:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]
+			if !test.exprIsNil {
+				mockExpr := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[int64]](ctrl)
+				expr = mockExpr
+
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return("hoge.huga", nil, test.exprErrs)
+			}
+
+			query, args, errs := test.aggregate(expr).Expr()
+			if test.isError {
+				assert.NotEmpty(t, errs)
+				return
+			}
+
+			if !assert.Nil(t, errs) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
+			assert.Nil(t, args)
+		})
+	}
+}
+
+func TestStringAggregate(t *testing.T) {
+	t.Parallel()
+
+	type orderItem struct {
+		direction genorm.OrderDirection
+		query     string
+		args      []genorm.ExprType
+	}
+
+	tests := []struct {
+		description   string
+		isStringAgg   bool
+		exprIsNil     bool
+		exprQuery     string
+		exprArgs      []genorm.ExprType
+		exprErrs      []error
+		distinct      bool
+		separator     *string
+		orderItems    []orderItem
+		expectedQuery string
+		expectedArgs  []genorm.ExprType
+		isError       bool
+	}{
+		{
+			description:   "group concat",
+			exprQuery:     "hoge.huga",
+			expectedQuery: "GROUP_CONCAT(hoge.huga SEPARATOR ',')",
+		},
+		{
+			description:   "group concat distinct",
+			exprQuery:     "hoge.huga",
+			distinct:      true,
+			expectedQuery: "GROUP_CONCAT(DISTINCT hoge.huga SEPARATOR ',')",
+		},
+		{
+			description: "group concat with order and separator",
+			exprQuery:   "(hoge.huga + ?)",
+			exprArgs:    []genorm.ExprType{genorm.Wrap(1)},
+			separator:   func() *string { s := "', \\"; return &s }(),
+			orderItems: []orderItem{
+				{direction: genorm.Desc, query: "(hoge.piyo = ?)", args: []genorm.ExprType{genorm.Wrap(2)}},
+			},
+			expectedQuery: "GROUP_CONCAT((hoge.huga + ?) ORDER BY (hoge.piyo = ?) DESC SEPARATOR ''', \\\\')",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(2)},
+		},
+		{
+			description: "string agg",
+			isStringAgg: true,
+			exprQuery:   "(hoge.huga + ?)",
+			exprArgs:    []genorm.ExprType{genorm.Wrap(1)},
+			separator:   func() *string { s := ";"; return &s }(),
+			orderItems: []orderItem{
+				{direction: genorm.Asc, query: "(hoge.piyo = ?)", args: []genorm.ExprType{genorm.Wrap(2)}},
+			},
+			expectedQuery: "STRING_AGG((hoge.huga + ?), ? ORDER BY (hoge.piyo = ?) ASC)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(";"), genorm.Wrap(2)},
+		},
+		{
+			description:   "string agg distinct",
+			isStringAgg:   true,
+			exprQuery:     "hoge.huga",
+			distinct:      true,
+			expectedQuery: "STRING_AGG(DISTINCT hoge.huga, ?)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(",")},
+		},
+		{
+			description:   "string agg with placeholder in separator",
+			isStringAgg:   true,
+			exprQuery:     "hoge.huga",
+			separator:     func() *string { s := "?"; return &s }(),
+			expectedQuery: "STRING_AGG(hoge.huga, ?)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap("?")},
+		},
+		{
+			description: "group concat with placeholder in separator",
+			exprQuery:   "hoge.huga",
+			separator:   func() *string { s := "?"; return &s }(),
+			isError:     true,
+		},
+		{
+			description: "nil expr",
+			exprIsNil:   true,
+			isError:     true,
+		},
+		{
+			description: "expr error",
+			exprErrs:    []error{errors.New("expr error")},
+			isError:     true,
+		},
+		{
+			description: "invalid order direction",
+			exprQuery:   "hoge.huga",
+			orderItems: []orderItem{
+				{direction: 0, query: "hoge.piyo"},
+			},
+			isError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]]
+			if !test.exprIsNil {
+				mockExpr := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[string]](ctrl)
+				expr = mockExpr
+
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return(test.exprQuery, test.exprArgs, test.exprErrs).
+					AnyTimes()
+			}
+
+			var res *genorm.StringAggregateExpr[*mock.MockTable]
+			if test.isStringAgg {
+				res = genorm.StringAgg(expr, test.distinct)
+			} else {
+				res = genorm.GroupConcat(expr, test.distinct)
+			}
+
+			if test.separator != nil {
+				res = res.Separator(*test.separator)
+			}
+
+			for _, item := range test.orderItems {
+				mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return(item.query, item.args, nil).
+					AnyTimes()
+
+				res = res.OrderBy(item.direction, mockExpr)
+			}
+
+			var typedRes genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]] = res
+
+			query, args, errs := typedRes.Expr()
+			if test.isError {
+				assert.NotEmpty(t, errs)
+				return
+			}
+
+			if !assert.Nil(t, errs) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
+
+func TestStringAggregateImmutable(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	newExpr := func(query string) *mock.MockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[string]] {
+		mockExpr := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[string]](ctrl)
+		mockExpr.
+			EXPECT().
+			Expr().
+			Return(query, nil, nil).
+			AnyTimes()
+
+		return mockExpr
+	}
+
+	base := genorm.GroupConcat(newExpr("hoge.huga"), false)
+
+	tests := []struct {
+		description string
+		expr        *genorm.StringAggregateExpr[*mock.MockTable]
+		query       string
+		isError     bool
+	}{
+		{
+			description: "extended order by",
+			expr:        base.OrderBy(genorm.Asc, newExpr("hoge.piyo")),
+			query:       "GROUP_CONCAT(hoge.huga ORDER BY hoge.piyo ASC SEPARATOR ',')",
+		},
+		{
+			description: "extended separator",
+			expr:        base.Separator(";"),
+			query:       "GROUP_CONCAT(hoge.huga SEPARATOR ';')",
+		},
+		{
+			description: "error in derived expr",
+			expr:        base.OrderBy(0, newExpr("hoge.piyo")),
+			isError:     true,
+		},
+		{
+			description: "base is not changed",
+			expr:        base,
+			query:       "GROUP_CONCAT(hoge.huga SEPARATOR ',')",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			query, args, errs := test.expr.Expr()
+			if test.isError {
+				assert.NotEmpty(t, errs)
+				return
+			}
+
+			if !assert.Nil(t, errs) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+			assert.Nil(t, args)
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package genorm
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type IndexHintType uint8
+
+const (
+	// UseIndex USE INDEX(MySQL only)
+	UseIndex IndexHintType = iota + 1
+	// ForceIndex FORCE INDEX(MySQL only)
+	ForceIndex
+	// IgnoreIndex IGNORE INDEX(MySQL only)
+	IgnoreIndex
+)
+
+func (iht IndexHintType) keyword() (string, error) {
+	switch iht {
+	case UseIndex:
+		return "USE INDEX", nil
+	case ForceIndex:
+		return "FORCE INDEX", nil
+	case IgnoreIndex:
+		return "IGNORE INDEX", nil
+	}
+
+	return "", fmt.Errorf("invalid index hint type: %d", iht)
+}
+
+var identifierRegexp = regexp.MustCompile(`^[0-9A-Za-z_$]+$`)
+
+type indexHint struct {
+	table    BasicTable
+	hintType IndexHintType
+	indexes  []string
+}
+
+type hintClause struct {
+	indexHints     []indexHint
+	optimizerHints []string
+}
+
+func (c *hintClause) addIndexHint(table BasicTable, hintType IndexHintType, indexes []string) error {
+	if table == nil {
+		return errors.New("nil table")
+	}
+
+	if _, ok := table.(LateralTable); ok {
+		return errors.New("index hint for a subquery")
+	}
+
+	_, err := hintType.keyword()
+	if err != nil {
+		return err
+	}
+
+	if len(indexes) == 0 {
+		return errors.New("no indexes")
+	}
+
+	for _, index := range indexes {
+		if !identifierRegexp.MatchString(index) {
+			return fmt.Errorf("invalid index name: %s", index)
+		}
+	}
+
+	// clip so that clones of the clause do not share the backing array
+	c.indexHints = append(slices.Clip(c.indexHints), indexHint{
+		table:    table,
+		hintType: hintType,
+		indexes:  indexes,
+	})
+
+	return nil
+}
+
+func (c *hintClause) addOptimizerHint(hint string) error {
+	hint = strings.TrimSpace(hint)
+	if len(hint) == 0 {
+		return errors.New("empty optimizer hint")
+	}
+
+	if strings.Contains(hint, "/*") || strings.Contains(hint, "*/") {
+		return fmt.Errorf("invalid optimizer hint: %s", hint)
+	}
+
+	// "?" in the query is a placeholder
+	if strings.Contains(hint, "?") {
+		return fmt.Errorf("optimizer hint contains ?: %s", hint)
+	}
+
+	c.optimizerHints = append(slices.Clip(c.optimizerHints), hint)
+
+	return nil
+}
+
+func (c *hintClause) validate(dialect Dialect) error {
+	if len(c.indexHints) == 0 && len(c.optimizerHints) == 0 {
+		return nil
+	}
+
+	if dialect != MySQL {
+		return errors.New("hints are supported only in MySQL")
+	}
+
+	return nil
+}
+
+// getOptimizerHintExpr "/*+ hint1 hint2 */ "
+func (c *hintClause) getOptimizerHintExpr() string {
+	if len(c.optimizerHints) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("/*+ %s */ ", strings.Join(c.optimizerHints, " "))
+}
+
+/*
+newIndexHints
+index hints rendered while the table references are rendered.
+nil if there are no index hints.
+*/
+func (c *hintClause) newIndexHints() (*IndexHints, error) {
+	if len(c.indexHints) == 0 {
+		return nil, nil
+	}
+
+	indexHints := &IndexHints{
+		hints:  map[string][]string{},
+		counts: map[string]int{},
+	}
+	for _, hint := range c.indexHints {
+		keyword, err := hint.hintType.keyword()
+		if err != nil {
+			return nil, err
+		}
+
+		tableName := hint.table.TableName()
+		if _, ok := indexHints.hints[tableName]; !ok {
+			indexHints.tableNames = append(indexHints.tableNames, tableName)
+		}
+
+		indexHints.hints[tableName] = append(indexHints.hints[tableName], fmt.Sprintf("%s (%s)", keyword, strings.Join(hint.indexes, ", ")))
+	}
+
+	return indexHints, nil
+}
+
+/*
+IndexHints
+index hints rendered right after the references of the hinted tables.
+e.g. (users INNER JOIN messages ON ...) -> (users USE INDEX (idx_name) INNER JOIN messages ON ...)
+*/
+type IndexHints struct {
+	tableNames []string
+	hints      map[string][]string
+	counts     map[string]int
+}
+
+/*
+TableExpr
+the table reference followed by the index hints of the table.
+subqueries are rendered without index hints.
+*/
+func (ih *IndexHints) TableExpr(table Table) (string, []ExprType, []error) {
+	if ih == nil {
+		return table.Expr()
+	}
+
+	switch t := table.(type) {
+	case IndexHintTable:
+		return t.IndexHintExpr(ih)
+	case LateralTable:
+		return t.Expr()
+	case BasicTable:
+		query, args, errs := t.Expr()
+		if len(errs) != 0 {
+			return "", nil, errs
+		}
+
+		hints, ok := ih.hints[t.TableName()]
+		if !ok {
+			return query, args, nil
+		}
+
+		ih.counts[t.TableName()]++
+
+		return query + " " + strings.Join(hints, " "), args, nil
+	}
+
+	return table.Expr()
+}
+
+// validate every hinted table is rendered exactly once
+func (ih *IndexHints) validate() error {
+	if ih == nil {
+		return nil
+	}
+
+	for _, tableName := range ih.tableNames {
+		switch ih.counts[tableName] {
+		case 0:
+			return fmt.Errorf("table %s is not in the query", tableName)
+		case 1:
+		default:
+			return fmt.Errorf("table %s appears more than once in the query", tableName)
+		}
+	}
+
+	return nil
+}
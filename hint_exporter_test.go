@@ -0,0 +1,22 @@
+package genorm
+
+//nolint:revive
+func NewHintClause() *hintClause {
+	return &hintClause{}
+}
+
+func (c *hintClause) AddIndexHint(table BasicTable, hintType IndexHintType, indexes ...string) error {
+	return c.addIndexHint(table, hintType, indexes)
+}
+
+func (c *hintClause) AddOptimizerHint(hint string) error {
+	return c.addOptimizerHint(hint)
+}
+
+func (c *hintClause) Validate(dialect Dialect) error {
+	return c.validate(dialect)
+}
+
+func (c *hintClause) GetOptimizerHintExpr() string {
+	return c.getOptimizerHintExpr()
+}
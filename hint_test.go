@@ -0,0 +1,416 @@
+package genorm_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mazrean/genorm"
+	"github.com/mazrean/genorm/mock"
+	"github.com/mazrean/genorm/relation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHintClauseAddIndexHint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		tableIsNil  bool
+		hintType    genorm.IndexHintType
+		indexes     []string
+		isError     bool
+	}{
+		{
+			description: "use index",
+			hintType:    genorm.UseIndex,
+			indexes:     []string{"idx_name"},
+		},
+		{
+			description: "multiple indexes",
+			hintType:    genorm.ForceIndex,
+			indexes:     []string{"idx_name", "PRIMARY"},
+		},
+		{
+			description: "nil table",
+			tableIsNil:  true,
+			hintType:    genorm.UseIndex,
+			indexes:     []string{"idx_name"},
+			isError:     true,
+		},
+		{
+			description: "invalid hint type",
+			hintType:    100,
+			indexes:     []string{"idx_name"},
+			isError:     true,
+		},
+		{
+			description: "no indexes",
+			hintType:    genorm.IgnoreIndex,
+			isError:     true,
+		},
+		{
+			description: "invalid index name",
+			hintType:    genorm.UseIndex,
+			indexes:     []string{"idx_name) FORCE INDEX (idx_other"},
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var table genorm.BasicTable
+			if !test.tableIsNil {
+				table = mock.NewMockBasicTable(ctrl)
+			}
+
+			err := genorm.NewHintClause().AddIndexHint(table, test.hintType, test.indexes...)
+			if test.isError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHintClauseOptimizerHint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		hints       []string
+		expected    string
+		isError     bool
+	}{
+		{
+			description: "no hints",
+			expected:    "",
+		},
+		{
+			description: "single hint",
+			hints:       []string{"MAX_EXECUTION_TIME(1000)"},
+			expected:    "/*+ MAX_EXECUTION_TIME(1000) */ ",
+		},
+		{
+			description: "multiple hints",
+			hints:       []string{"MAX_EXECUTION_TIME(1000)", " NO_INDEX_MERGE(users) "},
+			expected:    "/*+ MAX_EXECUTION_TIME(1000) NO_INDEX_MERGE(users) */ ",
+		},
+		{
+			description: "empty hint",
+			hints:       []string{" "},
+			isError:     true,
+		},
+		{
+			description: "comment end in hint",
+			hints:       []string{"BKA(users) */ DROP TABLE users; /*"},
+			isError:     true,
+		},
+		{
+			description: "placeholder in hint",
+			hints:       []string{"QB_NAME(?)"},
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			c := genorm.NewHintClause()
+
+			for _, hint := range test.hints {
+				err := c.AddOptimizerHint(hint)
+				if test.isError {
+					assert.Error(t, err)
+					return
+				}
+
+				if !assert.NoError(t, err) {
+					return
+				}
+			}
+
+			assert.Equal(t, test.expected, c.GetOptimizerHintExpr())
+		})
+	}
+}
+
+func TestHintClauseValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description   string
+		indexHint     bool
+		optimizerHint bool
+		dialect       genorm.Dialect
+		isError       bool
+	}{
+		{
+			description: "no hints postgres",
+			dialect:     genorm.PostgreSQL,
+		},
+		{
+			description: "index hint mysql",
+			indexHint:   true,
+			dialect:     genorm.MySQL,
+		},
+		{
+			description:   "optimizer hint mysql",
+			optimizerHint: true,
+			dialect:       genorm.MySQL,
+		},
+		{
+			description: "index hint postgres",
+			indexHint:   true,
+			dialect:     genorm.PostgreSQL,
+			isError:     true,
+		},
+		{
+			description:   "optimizer hint postgres",
+			optimizerHint: true,
+			dialect:       genorm.PostgreSQL,
+			isError:       true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			c := genorm.NewHintClause()
+
+			if test.indexHint {
+				err := c.AddIndexHint(mock.NewMockBasicTable(ctrl), genorm.UseIndex, "idx_name")
+				if !assert.NoError(t, err) {
+					return
+				}
+			}
+
+			if test.optimizerHint {
+				err := c.AddOptimizerHint("MAX_EXECUTION_TIME(1000)")
+				if !assert.NoError(t, err) {
+					return
+				}
+			}
+
+			err := c.Validate(test.dialect)
+			if test.isError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+type indexHintTestBuilder interface {
+	Errors() []error
+	BuildQuery() (string, []genorm.ExprType, error)
+}
+
+func TestIndexHints(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		builder     func() indexHintTestBuilder
+		query       string
+		isError     bool
+	}{
+		{
+			description: "basic table",
+			builder: func() indexHintTestBuilder {
+				return genorm.
+					Pluck(&aliasTestUser{}, aliasTestUserID).
+					IndexHint(&aliasTestUser{}, genorm.UseIndex, "idx_name")
+			},
+			query: "SELECT users.id AS res FROM users USE INDEX (idx_name)",
+		},
+		{
+			description: "multiple hints for a table",
+			builder: func() indexHintTestBuilder {
+				return genorm.
+					Pluck(&aliasTestUser{}, aliasTestUserID).
+					IndexHint(&aliasTestUser{}, genorm.UseIndex, "idx_name", "idx_created_at").
+					IndexHint(&aliasTestUser{}, genorm.IgnoreIndex, "PRIMARY")
+			},
+			query: "SELECT users.id AS res FROM users USE INDEX (idx_name, idx_created_at) IGNORE INDEX (PRIMARY)",
+		},
+		{
+			description: "joined table",
+			builder: func() indexHintTestBuilder {
+				pair := relation.Pair(&aliasTestUser{}, &preloadTestMessage{})
+				table := pair.Join(genorm.Eq(
+					relation.LeftExpr(pair, aliasTestUserID),
+					relation.RightExpr(pair, preloadTestMessageUserID),
+				))
+
+				return genorm.
+					Pluck(table, relation.LeftExpr(pair, aliasTestUserID)).
+					IndexHint(&preloadTestMessage{}, genorm.ForceIndex, "idx_user_id").
+					IndexHint(&aliasTestUser{}, genorm.UseIndex, "PRIMARY")
+			},
+			query: "SELECT users.id AS res FROM (users USE INDEX (PRIMARY) INNER JOIN messages FORCE INDEX (idx_user_id) ON (users.id = messages.user_id))",
+		},
+		{
+			description: "aliased table",
+			builder: func() indexHintTestBuilder {
+				pair := relation.Pair(&aliasTestUser{}, genorm.As[aliasTestManager](&aliasTestUser{}))
+				table := pair.Join(genorm.Eq(
+					relation.LeftExpr(pair, aliasTestUserID),
+					relation.RightExpr(pair, genorm.AliasColumn[aliasTestManager](aliasTestUserID)),
+				))
+
+				return genorm.
+					Pluck(table, relation.LeftExpr(pair, aliasTestUserID)).
+					IndexHint(genorm.As[aliasTestManager](&aliasTestUser{}), genorm.UseIndex, "PRIMARY").
+					IndexHint(&aliasTestUser{}, genorm.UseIndex, "idx_manager_id")
+			},
+			query: "SELECT users.id AS res FROM (users USE INDEX (idx_manager_id) INNER JOIN users AS manager USE INDEX (PRIMARY) ON (users.id = manager.id))",
+		},
+		{
+			description: "table in derived table",
+			builder: func() indexHintTestBuilder {
+				pair := relation.Pair(&aliasTestUser{}, genorm.Derive[derivedTestLatest](derivedTestFind()))
+
+				return genorm.
+					Pluck(pair.CrossJoin(), relation.LeftExpr(pair, aliasTestUserID)).
+					IndexHint(&preloadTestMessage{}, genorm.UseIndex, "idx_user_id")
+			},
+			isError: true,
+		},
+		{
+			description: "derived table",
+			builder: func() indexHintTestBuilder {
+				pair := relation.Pair(&aliasTestUser{}, genorm.Derive[derivedTestLatest](derivedTestFind()))
+
+				return genorm.
+					Pluck(pair.CrossJoin(), relation.LeftExpr(pair, aliasTestUserID)).
+					IndexHint(genorm.Derive[derivedTestLatest](derivedTestFind()), genorm.UseIndex, "idx_user_id")
+			},
+			isError: true,
+		},
+		{
+			description: "table not in query",
+			builder: func() indexHintTestBuilder {
+				return genorm.
+					Pluck(&aliasTestUser{}, aliasTestUserID).
+					IndexHint(&preloadTestMessage{}, genorm.UseIndex, "PRIMARY")
+			},
+			isError: true,
+		},
+		{
+			description: "table appears more than once",
+			builder: func() indexHintTestBuilder {
+				pair := relation.Pair(&aliasTestUser{}, &aliasTestUser{})
+
+				return genorm.
+					Pluck(pair.CrossJoin(), relation.LeftExpr(pair, aliasTestUserID)).
+					IndexHint(&aliasTestUser{}, genorm.UseIndex, "PRIMARY")
+			},
+			isError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			builder := test.builder()
+
+			query, _, err := builder.BuildQuery()
+			if test.isError {
+				assert.True(t, err != nil || len(builder.Errors()) != 0)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Empty(t, builder.Errors())
+			assert.Equal(t, test.query, query)
+		})
+	}
+}
+
+func TestSelectHints(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		dialect     genorm.Dialect
+		query       string
+		countQuery  string
+		isError     bool
+	}{
+		{
+			description: "mysql",
+			dialect:     genorm.MySQL,
+			query:       "SELECT /*+ MAX_EXECUTION_TIME(1000) */ DISTINCT hoge.huga AS hoge_huga_0 FROM hoge USE INDEX (idx_huga)",
+			countQuery:  "SELECT /*+ MAX_EXECUTION_TIME(1000) */ COUNT(*) FROM (SELECT DISTINCT hoge.huga AS hoge_huga_0 FROM hoge USE INDEX (idx_huga)) AS genorm_count",
+		},
+		{
+			description: "postgres",
+			dialect:     genorm.PostgreSQL,
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			table := mock.NewMockBasicTable(ctrl)
+			table.
+				EXPECT().
+				Expr().
+				Return("hoge", nil, nil).
+				AnyTimes()
+			table.
+				EXPECT().
+				TableName().
+				Return("hoge").
+				AnyTimes()
+			table.
+				EXPECT().
+				GetErrors().
+				Return(nil)
+
+			mockColumn := mock.NewMockColumn(ctrl)
+			mockColumn.EXPECT().TableName().Return("hoge").AnyTimes()
+			mockColumn.EXPECT().ColumnName().Return("huga").AnyTimes()
+			mockColumn.EXPECT().SQLColumnName().Return("hoge.huga").AnyTimes()
+			table.
+				EXPECT().
+				Columns().
+				Return([]genorm.Column{mockColumn}).
+				AnyTimes()
+
+			builder := genorm.
+				Select(table).
+				Dialect(test.dialect).
+				Distinct().
+				IndexHint(table, genorm.UseIndex, "idx_huga").
+				OptimizerHint("MAX_EXECUTION_TIME(1000)")
+
+			_, query, _, err := builder.BuildQuery()
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+
+			countQuery, _, err := builder.BuildCountQuery()
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.countQuery, countQuery)
+		})
+	}
+}
@@ -22,7 +22,16 @@ func Insert[T BasicTable](table T) *InsertContext[T] {
 	}
 }
 
+func (c *InsertContext[T]) clone() *InsertContext[T] {
+	clone := *c
+	clone.Context = c.Context.clone()
+
+	return &clone
+}
+
 func (c *InsertContext[T]) Values(tableBases ...T) *InsertContext[T] {
+	c = c.clone()
+
 	if len(tableBases) == 0 {
 		c.addError(errors.New("no values"))
 
@@ -40,6 +49,8 @@ func (c *InsertContext[T]) Values(tableBases ...T) *InsertContext[T] {
 }
 
 func (c *InsertContext[T]) Fields(fields ...TableColumns[T]) *InsertContext[T] {
+	c = c.clone()
+
 	if c.fields != nil {
 		c.addError(errors.New("fields already set"))
 		return c
@@ -110,7 +110,7 @@ func TestInsertBuildQuery(t *testing.T) {
 					fields = append(fields, mockColumn)
 				}
 
-				builder.Fields(tableFields...)
+				builder = builder.Fields(tableFields...)
 			} else {
 				for _, field := range test.fields {
 					mockColumn := mock.NewMockColumn(ctrl)
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+)
+
+var (
+	// flags
+	minSize     int
+	maxSize     int
+	destination string
+)
+
+func init() {
+	flag.IntVar(&minSize, "min", 2, "The smallest tuple size to generate.")
+	flag.IntVar(&maxSize, "max", 16, "The largest tuple size to generate.")
+	flag.StringVar(&destination, "destination", "", "The destination file to write.")
+}
+
+func main() {
+	flag.Parse()
+
+	if len(destination) == 0 {
+		panic("destination file path is required")
+	}
+
+	f, err := os.Create(destination)
+	if err != nil {
+		panic(fmt.Errorf("create destination: %w", err))
+	}
+	defer f.Close()
+
+	err = generate(f, minSize, maxSize)
+	if err != nil {
+		panic(err)
+	}
+}
+
+type tuple struct {
+	Size     int
+	Elements []int
+}
+
+var funcMap = template.FuncMap{
+	// join "f(1)<sep>f(2)<sep>..."
+	"join": func(elements []int, format string, sep string) string {
+		strs := make([]string, 0, len(elements))
+		for _, element := range elements {
+			strs = append(strs, strings.ReplaceAll(format, "#", fmt.Sprint(element)))
+		}
+
+		return strings.Join(strs, sep)
+	},
+	"dec": func(i int) int {
+		return i - 1
+	},
+	"repeat": func(count int, str string) string {
+		strs := make([]string, 0, count)
+		for range count {
+			strs = append(strs, str)
+		}
+
+		return strings.Join(strs, ", ")
+	},
+}
+
+var tupleTemplate = template.Must(template.New("tuple").Funcs(funcMap).Parse(`// Code generated by tuplegen. DO NOT EDIT.
+
+package genorm
+{{range .}}
+type Tuple{{.Size}}Struct[
+	S Table,
+{{- range .Elements}}
+	T{{.}} ExprType, U{{.}} ColumnFieldExprTypePointer[T{{.}}],
+{{- end}}
+] struct {
+{{- range .Elements}}
+	value{{.}} T{{.}}
+{{- end}}
+{{- range .Elements}}
+	expr{{.}} TypedTableExpr[S, T{{.}}]
+{{- end}}
+}
+
+func Tuple{{.Size}}[
+	S Table,
+{{- range .Elements}}
+	T{{.}} ExprType, U{{.}} ColumnFieldExprTypePointer[T{{.}}],
+{{- end}}
+]({{join .Elements "expr# TypedTableExpr[S, T#]" ", "}}) *Tuple{{.Size}}Struct[S, {{join .Elements "T#, U#" ", "}}] {
+	return &Tuple{{.Size}}Struct[S, {{join .Elements "T#, U#" ", "}}]{
+{{- range .Elements}}
+		expr{{.}}: expr{{.}},
+{{- end}}
+	}
+}
+
+func (t *Tuple{{.Size}}Struct[_, {{repeat .Size "_, _"}}]) Exprs() []Expr {
+	return []Expr{ {{- join .Elements "t.expr#" ", " -}} }
+}
+
+func (t *Tuple{{.Size}}Struct[_, {{join .Elements "_, U#" ", "}}]) Columns() []ColumnFieldExprType {
+	return []ColumnFieldExprType{
+{{- range .Elements}}
+		U{{.}}(&t.value{{.}}),
+{{- end}}
+	}
+}
+
+func (t *Tuple{{.Size}}Struct[_, {{join .Elements "T#, _" ", "}}]) Values() ({{join .Elements "T#" ", "}}) {
+	return {{join .Elements "t.value#" ", "}}
+}
+{{$tuple := printf "Tuple%dStruct[S, %s]" .Size (join .Elements "T#, U#" ", ")}}
+{{- $table := printf "DerivedTable[A, *%s, %s]" $tuple $tuple}}
+// DerivedTuple{{.Size}}Struct typed columns of the derived table of Tuple{{.Size}}
+type DerivedTuple{{.Size}}Struct[
+	D Table,
+{{- range .Elements}}
+	T{{.}} ExprType,
+{{- end}}
+] struct {
+{{- range .Elements}}
+	Value{{.}} TypedTableColumns[D, T{{.}}]
+	Value{{.}}Expr TypedTableExpr[D, T{{.}}]
+{{- end}}
+}
+
+// DerivedTuple{{.Size}} typed columns of the derived table of Tuple{{.Size}}(alias_name.value1, alias_name.value2, ...)
+func DerivedTuple{{.Size}}[
+	A Alias,
+	S Table,
+{{- range .Elements}}
+	T{{.}} ExprType, U{{.}} ColumnFieldExprTypePointer[T{{.}}],
+{{- end}}
+](_ *{{$table}}) *DerivedTuple{{.Size}}Struct[*{{$table}}, {{join .Elements "T#" ", "}}] {
+	return &DerivedTuple{{.Size}}Struct[*{{$table}}, {{join .Elements "T#" ", "}}]{
+{{- range .Elements}}
+		Value{{.}}: newDerivedTypedColumn[T{{.}}, A, *{{$tuple}}]({{dec .}}),
+		Value{{.}}Expr: newDerivedTypedColumn[T{{.}}, A, *{{$tuple}}]({{dec .}}),
+{{- end}}
+	}
+}
+{{end -}}
+`))
+
+func generate(w io.Writer, minSize int, maxSize int) error {
+	if minSize < 2 || minSize > maxSize {
+		return fmt.Errorf("invalid tuple size range: %d-%d", minSize, maxSize)
+	}
+
+	tuples := make([]tuple, 0, maxSize-minSize+1)
+	for size := minSize; size <= maxSize; size++ {
+		elements := make([]int, 0, size)
+		for i := 1; i <= size; i++ {
+			elements = append(elements, i)
+		}
+
+		tuples = append(tuples, tuple{
+			Size:     size,
+			Elements: elements,
+		})
+	}
+
+	buf := bytes.Buffer{}
+	err := tupleTemplate.Execute(&buf, tuples)
+	if err != nil {
+		return fmt.Errorf("execute template: %w", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("format source: %w", err)
+	}
+
+	_, err = w.Write(src)
+	if err != nil {
+		return fmt.Errorf("write source: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		description string
+		minSize     int
+		maxSize     int
+		err         bool
+	}{
+		{
+			description: "normal -> success",
+			minSize:     2,
+			maxSize:     16,
+		},
+		{
+			description: "single size -> success",
+			minSize:     6,
+			maxSize:     6,
+		},
+		{
+			description: "size 1 -> error",
+			minSize:     1,
+			maxSize:     16,
+			err:         true,
+		},
+		{
+			description: "min > max -> error",
+			minSize:     10,
+			maxSize:     6,
+			err:         true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			buf := bytes.Buffer{}
+			err := generate(&buf, test.minSize, test.maxSize)
+
+			if test.err {
+				if err == nil {
+					t.Error("expected error, but got nil")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if buf.Len() == 0 {
+				t.Error("generated source is empty")
+			}
+		})
+	}
+}
+
+func TestGeneratedFileUpToDate(t *testing.T) {
+	expected, err := os.ReadFile("../../../tuple_gen.go")
+	if err != nil {
+		t.Fatalf("failed to read tuple_gen.go: %s", err)
+	}
+
+	buf := bytes.Buffer{}
+	err = generate(&buf, 2, 16)
+	if err != nil {
+		t.Fatalf("failed to generate: %s", err)
+	}
+
+	if !bytes.Equal(expected, buf.Bytes()) {
+		t.Error("tuple_gen.go is out of date, run go generate")
+	}
+}
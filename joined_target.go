@@ -0,0 +1,60 @@
+package genorm
+
+import (
+	"errors"
+	"fmt"
+)
+
+// validateJoinedTarget target must be joined exactly once in table
+func validateJoinedTarget(table JoinedTable, target BasicTable) error {
+	if target == nil {
+		return errors.New("nil target")
+	}
+
+	count := 0
+	for _, baseTable := range table.BaseTables() {
+		if baseTable.TableName() == target.TableName() {
+			count++
+		}
+	}
+
+	switch count {
+	case 0:
+		return fmt.Errorf("target %s is not joined", target.TableName())
+	case 1:
+		return nil
+	}
+
+	return fmt.Errorf("target %s is joined more than once(use genorm.As)", target.TableName())
+}
+
+/*
+detachTarget
+the tables joined with target(USING/FROM clause in PostgreSQL) and the join condition.
+onQuery is empty for CROSS JOIN.
+*/
+func detachTarget(table JoinedTable, target BasicTable) (tableQuery string, tableArgs []ExprType, onQuery string, onArgs []ExprType, err error) {
+	detachableTable, ok := table.(DetachableTable)
+	if !ok {
+		return "", nil, "", nil, fmt.Errorf("%T does not support detaching the target", table)
+	}
+
+	restTable, onExpr, err := detachableTable.Detach(target)
+	if err != nil {
+		return "", nil, "", nil, fmt.Errorf("detach: %w", err)
+	}
+
+	tableQuery, tableArgs, errs := restTable.Expr()
+	if len(errs) != 0 {
+		return "", nil, "", nil, errs[0]
+	}
+
+	if onExpr != nil {
+		onQuery, onArgs, errs = onExpr.Expr()
+		if len(errs) != 0 {
+			return "", nil, "", nil, errs[0]
+		}
+	}
+
+	return tableQuery, tableArgs, onQuery, onArgs, nil
+}
@@ -0,0 +1,237 @@
+package genorm
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+func init() {
+	gob.Register(time.Time{})
+}
+
+// Cursor opaque cursor of keyset pagination. Empty cursor means the first page.
+type Cursor string
+
+func encodeCursor(values []driver.Value) (Cursor, error) {
+	gobValues := make([]any, 0, len(values))
+	for _, value := range values {
+		// MySQL driver returns strings as []byte, which should be compared as strings
+		if b, ok := value.([]byte); ok {
+			value = string(b)
+		}
+
+		gobValues = append(gobValues, value)
+	}
+
+	buf := bytes.Buffer{}
+	err := gob.NewEncoder(&buf).Encode(gobValues)
+	if err != nil {
+		return "", fmt.Errorf("encode cursor: %w", err)
+	}
+
+	return Cursor(base64.RawURLEncoding.EncodeToString(buf.Bytes())), nil
+}
+
+func decodeCursor(cursor Cursor) ([]driver.Value, error) {
+	b, err := base64.RawURLEncoding.DecodeString(string(cursor))
+	if err != nil {
+		return nil, fmt.Errorf("decode base64: %w", err)
+	}
+
+	var gobValues []any
+	err = gob.NewDecoder(bytes.NewReader(b)).Decode(&gobValues)
+	if err != nil {
+		return nil, fmt.Errorf("decode gob: %w", err)
+	}
+
+	values := make([]driver.Value, 0, len(gobValues))
+	for _, value := range gobValues {
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// cursorValue ExprType of a value decoded from Cursor
+type cursorValue struct {
+	value driver.Value
+}
+
+func (cv cursorValue) Value() (driver.Value, error) {
+	return cv.value, nil
+}
+
+type keysetClause[T Table] struct {
+	size      uint64
+	direction OrderDirection
+	columns   []TableColumns[T]
+	cursor    Cursor
+}
+
+func (c *keysetClause[T]) set(size uint64, direction OrderDirection, columns []TableColumns[T]) error {
+	if len(c.columns) != 0 {
+		return errors.New("paginate already set")
+	}
+	if size == 0 {
+		return errors.New("invalid page size")
+	}
+	if direction != Asc && direction != Desc {
+		return errors.New("invalid order direction")
+	}
+	if len(columns) == 0 {
+		return errors.New("no keyset columns")
+	}
+	for _, column := range columns {
+		if column == nil {
+			return errors.New("nil keyset column")
+		}
+	}
+
+	c.size = size
+	c.direction = direction
+	c.columns = columns
+
+	return nil
+}
+
+func (c *keysetClause[T]) setCursor(cursor Cursor) error {
+	if len(c.cursor) != 0 {
+		return errors.New("cursor already set")
+	}
+
+	c.cursor = cursor
+
+	return nil
+}
+
+func (c *keysetClause[T]) exists() bool {
+	return len(c.columns) != 0 || len(c.cursor) != 0
+}
+
+// apply rewrite clauses to fetch a page(size+1 rows to know whether the next page exists)
+func (c *keysetClause[T]) apply(
+	dialect Dialect,
+	whereCondition *whereConditionClause[T],
+	order *orderClause[T],
+	limit *limitClause,
+	offset *offsetClause,
+) error {
+	if len(c.columns) == 0 {
+		return errors.New("cursor set without paginate")
+	}
+	if order.exists() {
+		return errors.New("order by cannot be used with paginate")
+	}
+	if limit.exists() || offset.exists() {
+		return errors.New("limit and offset cannot be used with paginate")
+	}
+
+	for _, column := range c.columns {
+		err := order.add(orderItem[T]{
+			expr:      column,
+			direction: c.direction,
+		})
+		if err != nil {
+			return fmt.Errorf("order by: %w", err)
+		}
+	}
+
+	err := limit.set(c.size + 1)
+	if err != nil {
+		return fmt.Errorf("limit: %w", err)
+	}
+
+	if len(c.cursor) == 0 {
+		return nil
+	}
+
+	query, args, err := c.getConditionExpr(dialect)
+	if err != nil {
+		return fmt.Errorf("keyset condition: %w", err)
+	}
+
+	err = whereCondition.set(&ExprStruct[T, WrappedPrimitive[bool]]{
+		query: query,
+		args:  args,
+	})
+	if err != nil {
+		return fmt.Errorf("where condition: %w", err)
+	}
+
+	return nil
+}
+
+/*
+getConditionExpr
+PostgreSQL: ((a, b) > (?, ?))
+MySQL: ((a > ?) OR (a = ? AND b > ?))
+*/
+func (c *keysetClause[T]) getConditionExpr(dialect Dialect) (string, []ExprType, error) {
+	values, err := decodeCursor(c.cursor)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	if len(values) != len(c.columns) {
+		return "", nil, fmt.Errorf("invalid cursor: %d values for %d columns", len(values), len(c.columns))
+	}
+
+	var operator string
+	switch c.direction {
+	case Asc:
+		operator = ">"
+	case Desc:
+		operator = "<"
+	default:
+		return "", nil, fmt.Errorf("invalid order direction: %d", c.direction)
+	}
+
+	columnNames := make([]string, 0, len(c.columns))
+	for _, column := range c.columns {
+		columnNames = append(columnNames, column.SQLColumnName())
+	}
+
+	if len(c.columns) == 1 {
+		return fmt.Sprintf("(%s %s ?)", columnNames[0], operator), []ExprType{cursorValue{value: values[0]}}, nil
+	}
+
+	switch dialect {
+	case PostgreSQL:
+		placeholders := make([]string, 0, len(values))
+		args := make([]ExprType, 0, len(values))
+		for _, value := range values {
+			placeholders = append(placeholders, "?")
+			args = append(args, cursorValue{value: value})
+		}
+
+		return fmt.Sprintf(
+			"((%s) %s (%s))",
+			strings.Join(columnNames, ", "), operator, strings.Join(placeholders, ", "),
+		), args, nil
+	case MySQL:
+		// MySQL cannot use indexes well for row value comparisons
+		terms := make([]string, 0, len(c.columns))
+		args := []ExprType{}
+		for i := range c.columns {
+			parts := make([]string, 0, i+1)
+			for j := 0; j < i; j++ {
+				parts = append(parts, fmt.Sprintf("%s = ?", columnNames[j]))
+				args = append(args, cursorValue{value: values[j]})
+			}
+			parts = append(parts, fmt.Sprintf("%s %s ?", columnNames[i], operator))
+			args = append(args, cursorValue{value: values[i]})
+
+			terms = append(terms, fmt.Sprintf("(%s)", strings.Join(parts, " AND ")))
+		}
+
+		return fmt.Sprintf("(%s)", strings.Join(terms, " OR ")), args, nil
+	}
+
+	return "", nil, dialect.validate()
+}
@@ -0,0 +1,15 @@
+package genorm
+
+import "database/sql/driver"
+
+func EncodeCursor(values []driver.Value) (Cursor, error) {
+	return encodeCursor(values)
+}
+
+func DecodeCursor(cursor Cursor) ([]driver.Value, error) {
+	return decodeCursor(cursor)
+}
+
+func CursorValue(value driver.Value) ExprType {
+	return cursorValue{value: value}
+}
@@ -0,0 +1,497 @@
+package genorm_test
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mazrean/genorm"
+	"github.com/mazrean/genorm/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 4, 1, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		values      []driver.Value
+		expected    []driver.Value
+	}{
+		{
+			description: "int64",
+			values:      []driver.Value{int64(1)},
+			expected:    []driver.Value{int64(1)},
+		},
+		{
+			description: "multiple types",
+			values:      []driver.Value{int64(1), "hoge", 1.5, true, now},
+			expected:    []driver.Value{int64(1), "hoge", 1.5, true, now},
+		},
+		{
+			description: "bytes are decoded as string",
+			values:      []driver.Value{[]byte("hoge")},
+			expected:    []driver.Value{"hoge"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			cursor, err := genorm.EncodeCursor(test.values)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			values, err := genorm.DecodeCursor(cursor)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.expected, values)
+		})
+	}
+}
+
+func TestDecodeInvalidCursor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		cursor      genorm.Cursor
+	}{
+		{
+			description: "invalid base64",
+			cursor:      "!!!",
+		},
+		{
+			description: "invalid gob",
+			cursor:      "aG9nZQ",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			_, err := genorm.DecodeCursor(test.cursor)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSelectPaginate(t *testing.T) {
+	t.Parallel()
+
+	cursor, err := genorm.EncodeCursor([]driver.Value{int64(10), "hoge"})
+	if err != nil {
+		t.Fatalf("failed to encode cursor: %v", err)
+	}
+
+	singleCursor, err := genorm.EncodeCursor([]driver.Value{int64(10)})
+	if err != nil {
+		t.Fatalf("failed to encode cursor: %v", err)
+	}
+
+	type paginate struct {
+		size      uint64
+		direction genorm.OrderDirection
+		columns   []string
+	}
+
+	tests := []struct {
+		description    string
+		dialect        genorm.Dialect
+		paginate       *paginate
+		cursor         genorm.Cursor
+		whereCondition bool
+		orderBy        bool
+		limit          uint64
+		query          string
+		args           []genorm.ExprType
+		err            bool
+	}{
+		{
+			description: "first page",
+			paginate: &paginate{
+				size:      10,
+				direction: genorm.Asc,
+				columns:   []string{"hoge.huga", "hoge.piyo"},
+			},
+			query: "SELECT hoge.huga AS hoge_huga_0, hoge.piyo AS hoge_piyo_0 FROM hoge ORDER BY hoge.huga ASC, hoge.piyo ASC LIMIT 11",
+			args:  []genorm.ExprType{},
+		},
+		{
+			description: "mysql next page",
+			paginate: &paginate{
+				size:      10,
+				direction: genorm.Asc,
+				columns:   []string{"hoge.huga", "hoge.piyo"},
+			},
+			cursor: cursor,
+			query:  "SELECT hoge.huga AS hoge_huga_0, hoge.piyo AS hoge_piyo_0 FROM hoge WHERE ((hoge.huga > ?) OR (hoge.huga = ? AND hoge.piyo > ?)) ORDER BY hoge.huga ASC, hoge.piyo ASC LIMIT 11",
+			args: []genorm.ExprType{
+				genorm.CursorValue(int64(10)),
+				genorm.CursorValue(int64(10)),
+				genorm.CursorValue("hoge"),
+			},
+		},
+		{
+			description: "postgres next page",
+			dialect:     genorm.PostgreSQL,
+			paginate: &paginate{
+				size:      10,
+				direction: genorm.Desc,
+				columns:   []string{"hoge.huga", "hoge.piyo"},
+			},
+			cursor: cursor,
+			query:  "SELECT hoge.huga AS hoge_huga_0, hoge.piyo AS hoge_piyo_0 FROM hoge WHERE ((hoge.huga, hoge.piyo) < (?, ?)) ORDER BY hoge.huga DESC, hoge.piyo DESC LIMIT 11",
+			args: []genorm.ExprType{
+				genorm.CursorValue(int64(10)),
+				genorm.CursorValue("hoge"),
+			},
+		},
+		{
+			description: "single column with where",
+			paginate: &paginate{
+				size:      5,
+				direction: genorm.Desc,
+				columns:   []string{"hoge.huga"},
+			},
+			cursor:         singleCursor,
+			whereCondition: true,
+			query:          "SELECT hoge.huga AS hoge_huga_0 FROM hoge WHERE ((hoge.piyo = ?) AND (hoge.huga < ?)) ORDER BY hoge.huga DESC LIMIT 6",
+			args: []genorm.ExprType{
+				genorm.Wrap(1),
+				genorm.CursorValue(int64(10)),
+			},
+		},
+		{
+			description: "cursor column count mismatch",
+			paginate: &paginate{
+				size:      10,
+				direction: genorm.Asc,
+				columns:   []string{"hoge.huga"},
+			},
+			cursor: cursor,
+			err:    true,
+		},
+		{
+			description: "invalid cursor",
+			paginate: &paginate{
+				size:      10,
+				direction: genorm.Asc,
+				columns:   []string{"hoge.huga"},
+			},
+			cursor: "!!!",
+			err:    true,
+		},
+		{
+			description: "cursor without paginate",
+			cursor:      cursor,
+			err:         true,
+		},
+		{
+			description: "order by with paginate",
+			paginate: &paginate{
+				size:      10,
+				direction: genorm.Asc,
+				columns:   []string{"hoge.huga"},
+			},
+			orderBy: true,
+			err:     true,
+		},
+		{
+			description: "limit with paginate",
+			paginate: &paginate{
+				size:      10,
+				direction: genorm.Asc,
+				columns:   []string{"hoge.huga"},
+			},
+			limit: 10,
+			err:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			table := mock.NewMockTable(ctrl)
+			table.
+				EXPECT().
+				Expr().
+				Return("hoge", nil, nil).
+				AnyTimes()
+			table.
+				EXPECT().
+				GetErrors().
+				Return(nil)
+
+			builder := genorm.Select(table)
+			if test.dialect != 0 {
+				builder = builder.Dialect(test.dialect)
+			}
+
+			newColumn := func(sqlColumnName string) *mock.MockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[int]] {
+				mockColumn := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[int]](ctrl)
+				mockColumn.EXPECT().Expr().Return(sqlColumnName, nil, nil).AnyTimes()
+				mockColumn.EXPECT().SQLColumnName().Return(sqlColumnName).AnyTimes()
+				mockColumn.EXPECT().TableName().Return("hoge").AnyTimes()
+				mockColumn.EXPECT().ColumnName().Return(sqlColumnName[len("hoge."):]).AnyTimes()
+
+				return mockColumn
+			}
+
+			columns := []genorm.TableColumns[*mock.MockTable]{newColumn("hoge.huga")}
+			if test.paginate != nil {
+				columns = make([]genorm.TableColumns[*mock.MockTable], 0, len(test.paginate.columns))
+				for _, column := range test.paginate.columns {
+					columns = append(columns, newColumn(column))
+				}
+
+				builder = builder.Paginate(test.paginate.size, test.paginate.direction, columns...)
+			}
+			builder = builder.Fields(columns...)
+
+			if len(test.cursor) != 0 {
+				builder = builder.After(test.cursor)
+			}
+
+			if test.whereCondition {
+				mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return("(hoge.piyo = ?)", []genorm.ExprType{genorm.Wrap(1)}, nil).
+					AnyTimes()
+
+				builder = builder.Where(mockExpr)
+			}
+
+			if test.orderBy {
+				builder = builder.OrderBy(genorm.Asc, columns[0])
+			}
+
+			if test.limit != 0 {
+				builder = builder.Limit(test.limit)
+			}
+
+			assert.Empty(t, builder.Errors())
+
+			_, query, args, err := builder.BuildQuery()
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+			assert.Equal(t, test.args, args)
+		})
+	}
+}
+
+func TestPaginateArgs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		size        uint64
+		direction   genorm.OrderDirection
+		noColumns   bool
+		twice       bool
+		dialect     genorm.Dialect
+		isError     bool
+	}{
+		{
+			description: "normal",
+			size:        10,
+			direction:   genorm.Asc,
+		},
+		{
+			description: "zero size",
+			direction:   genorm.Asc,
+			isError:     true,
+		},
+		{
+			description: "invalid direction",
+			size:        10,
+			isError:     true,
+		},
+		{
+			description: "no columns",
+			size:        10,
+			direction:   genorm.Asc,
+			noColumns:   true,
+			isError:     true,
+		},
+		{
+			description: "paginate twice",
+			size:        10,
+			direction:   genorm.Asc,
+			twice:       true,
+			isError:     true,
+		},
+		{
+			description: "invalid dialect",
+			size:        10,
+			direction:   genorm.Asc,
+			dialect:     100,
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			table := mock.NewMockTable(ctrl)
+			table.
+				EXPECT().
+				GetErrors().
+				Return(nil)
+
+			mockTuple := mock.NewMockTuple(ctrl)
+
+			builder := genorm.Find(table, mockTuple)
+			if test.dialect != 0 {
+				builder = builder.Dialect(test.dialect)
+			}
+
+			var columns []genorm.TableColumns[*mock.MockTable]
+			if !test.noColumns {
+				columns = append(columns, mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[int]](ctrl))
+			}
+
+			builder = builder.Paginate(test.size, test.direction, columns...)
+			if test.twice {
+				builder = builder.Paginate(test.size, test.direction, columns...)
+			}
+
+			if test.isError {
+				assert.NotEmpty(t, builder.Errors())
+			} else {
+				assert.Empty(t, builder.Errors())
+			}
+		})
+	}
+}
+
+func TestFindPaginate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	cursor, err := genorm.EncodeCursor([]driver.Value{int64(10)})
+	if err != nil {
+		t.Fatalf("failed to encode cursor: %v", err)
+	}
+
+	table := mock.NewMockTable(ctrl)
+	table.
+		EXPECT().
+		Expr().
+		Return("hoge", nil, nil)
+	table.
+		EXPECT().
+		GetErrors().
+		Return(nil)
+
+	mockField := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int]](ctrl)
+	mockField.
+		EXPECT().
+		Expr().
+		Return("hoge.huga", nil, nil)
+	mockTuple := mock.NewMockTuple(ctrl)
+	mockTuple.
+		EXPECT().
+		Exprs().
+		Return([]genorm.Expr{mockField})
+
+	mockColumn := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[int]](ctrl)
+	mockColumn.EXPECT().Expr().Return("hoge.id", nil, nil).AnyTimes()
+	mockColumn.EXPECT().SQLColumnName().Return("hoge.id").AnyTimes()
+
+	builder := genorm.Find(table, mockTuple).
+		Paginate(2, genorm.Asc, mockColumn).
+		After(cursor)
+
+	query, args, err := builder.BuildQuery()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "SELECT hoge.huga AS value0 FROM hoge WHERE (hoge.id > ?) ORDER BY hoge.id ASC LIMIT 3", query)
+	assert.Equal(t, []genorm.ExprType{genorm.CursorValue(int64(10))}, args)
+}
+
+func TestFindKeysetPositions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description  string
+		keysetColumn string
+		positions    []int
+		isError      bool
+	}{
+		{
+			description:  "selected",
+			keysetColumn: "hoge.id",
+			positions:    []int{1},
+		},
+		{
+			description:  "not selected",
+			keysetColumn: "hoge.created_at",
+			isError:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			table := mock.NewMockTable(ctrl)
+			table.
+				EXPECT().
+				GetErrors().
+				Return(nil)
+
+			fields := make([]genorm.Expr, 0, 2)
+			for _, fieldQuery := range []string{"hoge.huga", "hoge.id"} {
+				mockField := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int]](ctrl)
+				mockField.
+					EXPECT().
+					Expr().
+					Return(fieldQuery, nil, nil)
+				fields = append(fields, mockField)
+			}
+
+			mockTuple := mock.NewMockTuple(ctrl)
+			mockTuple.
+				EXPECT().
+				Exprs().
+				Return(fields)
+
+			mockColumn := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[int]](ctrl)
+			mockColumn.EXPECT().SQLColumnName().Return(test.keysetColumn).AnyTimes()
+
+			positions, err := genorm.Find(table, mockTuple).
+				Paginate(2, genorm.Asc, mockColumn).
+				KeysetPositions()
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.positions, positions)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package genorm
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+/*
+Nullable
+value which can be NULL, e.g. the columns of the table outer joined.
+T must be a type whose pointer implements sql.Scanner.
+*/
+type Nullable[T ExprType] struct {
+	valid bool
+	val   T
+}
+
+func NewNullable[T ExprType](val T) Nullable[T] {
+	return Nullable[T]{
+		valid: true,
+		val:   val,
+	}
+}
+
+func (n *Nullable[T]) Scan(src any) error {
+	if src == nil {
+		var zero T
+		n.valid = false
+		n.val = zero
+
+		return nil
+	}
+
+	scanner, ok := any(&n.val).(sql.Scanner)
+	if !ok {
+		return fmt.Errorf("%s is not a sql.Scanner", reflect.TypeFor[*T]())
+	}
+
+	err := scanner.Scan(src)
+	if err != nil {
+		return err
+	}
+
+	n.valid = true
+
+	return nil
+}
+
+func (n Nullable[_]) Value() (driver.Value, error) {
+	if !n.valid {
+		return nil, ErrNullValue
+	}
+
+	return n.val.Value()
+}
+
+func (n Nullable[T]) Val() (T, bool) {
+	return n.val, n.valid
+}
+
+type nullableExpr[T Table, S ExprType] struct {
+	expr TypedTableExpr[T, S]
+}
+
+func (ne *nullableExpr[_, _]) Expr() (string, []ExprType, []error) {
+	return ne.expr.Expr()
+}
+
+func (ne *nullableExpr[T, _]) TableExpr(T) (string, []ExprType, []error) {
+	return ne.Expr()
+}
+
+func (ne *nullableExpr[_, S]) TypedExpr(Nullable[S]) (string, []ExprType, []error) {
+	return ne.Expr()
+}
+
+// NullableExpr expr as the expression which can be NULL
+func NullableExpr[T Table, S ExprType](expr TypedTableExpr[T, S]) TypedTableExpr[T, Nullable[S]] {
+	if expr == nil {
+		return &ExprStruct[T, Nullable[S]]{
+			errs: []error{errors.New("NullableExpr: nil expression")},
+		}
+	}
+
+	return &nullableExpr[T, S]{
+		expr: expr,
+	}
+}
+
+type nullableColumn[T Table, S ExprType] struct {
+	nullableExpr[T, S]
+	column Column
+}
+
+func (nc *nullableColumn[_, _]) SQLColumnName() string {
+	return nc.column.SQLColumnName()
+}
+
+func (nc *nullableColumn[_, _]) TableName() string {
+	return nc.column.TableName()
+}
+
+func (nc *nullableColumn[_, _]) ColumnName() string {
+	return nc.column.ColumnName()
+}
+
+// NullableColumn column as the column which can be NULL
+func NullableColumn[T Table, S ExprType](column TypedTableColumns[T, S]) TypedTableColumns[T, Nullable[S]] {
+	return &nullableColumn[T, S]{
+		nullableExpr: nullableExpr[T, S]{
+			expr: column,
+		},
+		column: column,
+	}
+}
+
+/*
+outerJoinedScanner
+scanner of the column of the table outer joined.
+NULL is not scanned by the column field, which is left as the zero value, and recorded as null.
+*/
+type outerJoinedScanner struct {
+	scanner sql.Scanner
+	null    bool
+}
+
+func (ojs *outerJoinedScanner) Scan(src any) error {
+	ojs.null = src == nil
+	if ojs.null {
+		return nil
+	}
+
+	return ojs.scanner.Scan(src)
+}
+
+/*
+NullTables
+base tables which are NULL by outer joins in the row scanned by Select.
+Joined tables embed it as a field to implement NullTableSetter.
+*/
+type NullTables struct {
+	tableNames map[string]struct{}
+}
+
+func (nt *NullTables) SetNull(table BasicTable) {
+	if nt.tableNames == nil {
+		nt.tableNames = map[string]struct{}{}
+	}
+
+	nt.tableNames[table.TableName()] = struct{}{}
+}
+
+// IsNull whether all the columns of table are NULL by outer joins
+func (nt *NullTables) IsNull(table BasicTable) bool {
+	_, ok := nt.tableNames[table.TableName()]
+
+	return ok
+}
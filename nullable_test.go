@@ -0,0 +1,341 @@
+package genorm_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/mazrean/genorm"
+	"github.com/mazrean/genorm/relation"
+	"github.com/stretchr/testify/assert"
+)
+
+type nullableTestValuer struct{}
+
+func (nullableTestValuer) Value() (driver.Value, error) {
+	return nil, nil
+}
+
+func TestNullableScan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		src         any
+		value       genorm.WrappedPrimitive[string]
+		valid       bool
+		isError     bool
+	}{
+		{
+			description: "value",
+			src:         "hoge",
+			value:       genorm.Wrap("hoge"),
+			valid:       true,
+		},
+		{
+			description: "null",
+			src:         nil,
+			valid:       false,
+		},
+		{
+			description: "invalid value",
+			src:         struct{}{},
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			nullable := genorm.NewNullable(genorm.Wrap("fuga"))
+
+			err := nullable.Scan(test.src)
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			value, valid := nullable.Val()
+			assert.Equal(t, test.valid, valid)
+			assert.Equal(t, test.value, value)
+		})
+	}
+}
+
+func TestNullableScanNotScanner(t *testing.T) {
+	t.Parallel()
+
+	var nullable genorm.Nullable[nullableTestValuer]
+
+	assert.NoError(t, nullable.Scan(nil))
+	assert.Error(t, nullable.Scan("hoge"))
+}
+
+func TestNullableValue(t *testing.T) {
+	t.Parallel()
+
+	value, err := genorm.NewNullable(genorm.Wrap("hoge")).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "hoge", value)
+
+	var null genorm.Nullable[genorm.WrappedPrimitive[string]]
+	_, err = null.Value()
+	assert.ErrorIs(t, err, genorm.ErrNullValue)
+}
+
+func TestNullableExpr(t *testing.T) {
+	t.Parallel()
+
+	column := genorm.NullableColumn(aliasTestUserID)
+	assert.Equal(t, "users.id", column.SQLColumnName())
+	assert.Equal(t, "users", column.TableName())
+	assert.Equal(t, "id", column.ColumnName())
+
+	query, args, errs := genorm.NullableExpr[*aliasTestUser, genorm.WrappedPrimitive[int64]](aliasTestUserID).Expr()
+	assert.Empty(t, errs)
+	assert.Equal(t, "users.id", query)
+	assert.Empty(t, args)
+
+	_, _, errs = genorm.NullableExpr[*aliasTestUser, genorm.WrappedPrimitive[int64]](nil).Expr()
+	assert.Len(t, errs, 1)
+}
+
+func TestSelectOuterJoinColumnDests(t *testing.T) {
+	t.Parallel()
+
+	pair := relation.Pair(&aliasTestUser{}, genorm.As[aliasTestManager](&aliasTestUser{}))
+	joinedTable := pair.LeftJoin(genorm.Eq(
+		relation.LeftExpr(pair, aliasTestUserID),
+		relation.RightExpr(pair, genorm.AliasColumn[aliasTestManager](aliasTestUserID)),
+	))
+
+	var table relation.PairTable[aliasTestUser, *aliasTestUser, genorm.AliasedTable[aliasTestUser, *aliasTestUser, aliasTestManager], *genorm.AliasedTable[aliasTestUser, *aliasTestUser, aliasTestManager]]
+	dests, setNullTables, err := genorm.
+		Select(joinedTable).
+		ColumnDests(&table, []genorm.Column{
+			relation.Left(pair, aliasTestUserID),
+			relation.Right(pair, genorm.AliasColumn[aliasTestManager](aliasTestUserID)),
+		})
+	if !assert.NoError(t, err) || !assert.Len(t, dests, 2) {
+		return
+	}
+
+	// the left table is not nullable
+	assert.Same(t, &table.Left().ID, dests[0])
+
+	// the right table can be NULL by LEFT JOIN
+	scanner, ok := dests[1].(sql.Scanner)
+	if !assert.True(t, ok) {
+		return
+	}
+
+	assert.NoError(t, scanner.Scan(int64(1)))
+	setNullTables()
+
+	right, valid := table.NullableRight()
+	assert.True(t, valid)
+
+	value, valid := right.Table().ID.Val()
+	assert.True(t, valid)
+	assert.Equal(t, int64(1), value)
+
+	assert.NoError(t, scanner.Scan(nil))
+	setNullTables()
+
+	_, valid = table.NullableRight()
+	assert.False(t, valid)
+
+	_, valid = table.NullableLeft()
+	assert.True(t, valid)
+}
+
+// nullableTestBio column type which cannot be NULL
+type nullableTestBio struct {
+	val string
+}
+
+func (b *nullableTestBio) Scan(src any) error {
+	val, ok := src.(string)
+	if !ok {
+		return fmt.Errorf("unexpected bio: %v", src)
+	}
+
+	b.val = val
+
+	return nil
+}
+
+func (b nullableTestBio) Value() (driver.Value, error) {
+	return b.val, nil
+}
+
+type nullableTestProfile struct {
+	UserID genorm.WrappedPrimitive[int64]
+	Bio    nullableTestBio
+}
+
+var (
+	nullableTestProfileUserID genorm.TypedTableColumns[*nullableTestProfile, genorm.WrappedPrimitive[int64]] = preloadTestColumn[*nullableTestProfile, genorm.WrappedPrimitive[int64]]{tableName: "profiles", columnName: "user_id"}
+	nullableTestProfileBio    genorm.TypedTableColumns[*nullableTestProfile, nullableTestBio]                = preloadTestColumn[*nullableTestProfile, nullableTestBio]{tableName: "profiles", columnName: "bio"}
+)
+
+func (*nullableTestProfile) TableName() string {
+	return "profiles"
+}
+
+func (t *nullableTestProfile) Expr() (string, []genorm.ExprType, []error) {
+	return t.TableName(), nil, nil
+}
+
+func (*nullableTestProfile) Columns() []genorm.Column {
+	return []genorm.Column{nullableTestProfileUserID, nullableTestProfileBio}
+}
+
+func (t *nullableTestProfile) ColumnMap() map[string]genorm.ColumnFieldExprType {
+	return map[string]genorm.ColumnFieldExprType{
+		nullableTestProfileUserID.SQLColumnName(): &t.UserID,
+		nullableTestProfileBio.SQLColumnName():    &t.Bio,
+	}
+}
+
+func (*nullableTestProfile) GetErrors() []error {
+	return nil
+}
+
+// nullableTestConnector driver.Connector whose queries return rows regardless of the query
+type nullableTestConnector struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (c *nullableTestConnector) Connect(context.Context) (driver.Conn, error) {
+	return &nullableTestConn{connector: c}, nil
+}
+
+func (c *nullableTestConnector) Driver() driver.Driver {
+	return nil
+}
+
+type nullableTestConn struct {
+	connector *nullableTestConnector
+}
+
+func (*nullableTestConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (*nullableTestConn) Close() error {
+	return nil
+}
+
+func (*nullableTestConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *nullableTestConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	return &nullableTestRows{
+		columns: c.connector.columns,
+		rows:    c.connector.rows,
+	}, nil
+}
+
+type nullableTestRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *nullableTestRows) Columns() []string {
+	return r.columns
+}
+
+func (*nullableTestRows) Close() error {
+	return nil
+}
+
+func (r *nullableTestRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+
+	return nil
+}
+
+func TestSelectOuterJoinNull(t *testing.T) {
+	t.Parallel()
+
+	db := sql.OpenDB(&nullableTestConnector{
+		columns: []string{"users_id", "profiles_user_id", "profiles_bio"},
+		rows: [][]driver.Value{
+			{int64(1), int64(1), "hoge"},
+			{int64(2), nil, nil},
+		},
+	})
+	defer db.Close()
+
+	pair := relation.Pair(&aliasTestUser{}, &nullableTestProfile{})
+	joinedTable := pair.LeftJoin(genorm.Eq(
+		relation.LeftExpr(pair, aliasTestUserID),
+		relation.RightExpr(pair, nullableTestProfileUserID),
+	))
+
+	tables, err := genorm.
+		Select(joinedTable).
+		GetAll(db)
+	if !assert.NoError(t, err) || !assert.Len(t, tables, 2) {
+		return
+	}
+
+	profile, valid := tables[0].NullableRight()
+	assert.True(t, valid)
+	assert.Equal(t, "hoge", profile.Bio.val)
+
+	// the profile NULL by LEFT JOIN is left as the zero value
+	profile, valid = tables[1].NullableRight()
+	assert.False(t, valid)
+	assert.Equal(t, nullableTestBio{}, profile.Bio)
+
+	user, valid := tables[1].NullableLeft()
+	assert.True(t, valid)
+
+	id, _ := user.ID.Val()
+	assert.Equal(t, int64(2), id)
+}
+
+func TestPairNullable(t *testing.T) {
+	t.Parallel()
+
+	pair := relation.Pair(&aliasTestUser{}, &preloadTestMessage{})
+	joinedTable := pair.LeftJoin(genorm.Eq(
+		relation.LeftExpr(pair, aliasTestUserID),
+		relation.RightExpr(pair, preloadTestMessageUserID),
+	))
+
+	query, _, err := genorm.
+		Find(joinedTable, genorm.Tuple2(
+			relation.Left(pair, aliasTestUserID),
+			relation.RightNullable(pair, preloadTestMessageID),
+		)).
+		BuildQuery()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "SELECT users.id AS value0, messages.id AS value1 FROM (users LEFT JOIN messages ON (users.id = messages.user_id))", query)
+
+	column := relation.RightNullableExpr(pair, preloadTestMessageID)
+	query, _, errs := column.Expr()
+	assert.Empty(t, errs)
+	assert.Equal(t, "messages.id", query)
+}
@@ -28,8 +28,10 @@ func Assign[T Table, S ExprType](
 	}
 
 	return &TableAssignExpr[T]{
-		query: fmt.Sprintf("%s = %s", query1, query2),
-		args:  append(args1, args2...),
+		query:      fmt.Sprintf("%s = %s", query1, query2),
+		column:     expr1,
+		valueQuery: query2,
+		args:       append(args1, args2...),
 	}
 }
 
@@ -52,42 +54,35 @@ func AssignLit[T Table, S ExprType](
 	}
 
 	return &TableAssignExpr[T]{
-		query: fmt.Sprintf("%s = ?", query),
-		args:  append(args, literal),
+		query:      fmt.Sprintf("%s = ?", query),
+		column:     expr,
+		valueQuery: "?",
+		args:       append(args, literal),
 	}
 }
 
 // Logical Operators
 
-// And (expr1 AND expr2)
+// And (expr1 AND expr2). nil operands are skipped as in AndAll.
 func And[T Table](
 	expr1 TypedTableExpr[T, WrappedPrimitive[bool]],
 	expr2 TypedTableExpr[T, WrappedPrimitive[bool]],
 ) TypedTableExpr[T, WrappedPrimitive[bool]] {
-	if expr1 == nil || expr2 == nil {
-		return &ExprStruct[T, WrappedPrimitive[bool]]{
-			errs: []error{errors.New("Assign: nil expression")},
-		}
-	}
-
-	query1, args1, errs1 := expr1.Expr()
-	query2, args2, errs2 := expr2.Expr()
-	if len(errs1) != 0 || len(errs2) != 0 {
-		return &ExprStruct[T, WrappedPrimitive[bool]]{
-			errs: append(errs1, errs2...),
-		}
-	}
-
-	return &ExprStruct[T, WrappedPrimitive[bool]]{
-		query: fmt.Sprintf("(%s AND %s)", query1, query2),
-		args:  append(args1, args2...),
-	}
+	return logicalAll("AND", "TRUE", []TypedTableExpr[T, WrappedPrimitive[bool]]{expr1, expr2})
 }
 
-// Or (expr1 OR expr2)
+// Or (expr1 OR expr2). nil operands are skipped as in OrAny.
 func Or[T Table](
 	expr1 TypedTableExpr[T, WrappedPrimitive[bool]],
 	expr2 TypedTableExpr[T, WrappedPrimitive[bool]],
+) TypedTableExpr[T, WrappedPrimitive[bool]] {
+	return logicalAll("OR", "FALSE", []TypedTableExpr[T, WrappedPrimitive[bool]]{expr1, expr2})
+}
+
+// Xor (expr1 XOR expr2)
+func Xor[T Table](
+	expr1 TypedTableExpr[T, WrappedPrimitive[bool]],
+	expr2 TypedTableExpr[T, WrappedPrimitive[bool]],
 ) TypedTableExpr[T, WrappedPrimitive[bool]] {
 	if expr1 == nil || expr2 == nil {
 		return &ExprStruct[T, WrappedPrimitive[bool]]{
@@ -104,33 +99,73 @@ func Or[T Table](
 	}
 
 	return &ExprStruct[T, WrappedPrimitive[bool]]{
-		query: fmt.Sprintf("(%s OR %s)", query1, query2),
+		query: fmt.Sprintf("(%s XOR %s)", query1, query2),
 		args:  append(args1, args2...),
 	}
 }
 
-// Xor (expr1 XOR expr2)
-func Xor[T Table](
-	expr1 TypedTableExpr[T, WrappedPrimitive[bool]],
-	expr2 TypedTableExpr[T, WrappedPrimitive[bool]],
+// AndAll (expr1 AND expr2 AND ...). nil operands are skipped and zero operands is TRUE.
+func AndAll[T Table](
+	exprs ...TypedTableExpr[T, WrappedPrimitive[bool]],
 ) TypedTableExpr[T, WrappedPrimitive[bool]] {
-	if expr1 == nil || expr2 == nil {
+	return logicalAll("AND", "TRUE", exprs)
+}
+
+// OrAny (expr1 OR expr2 OR ...). nil operands are skipped and zero operands is FALSE.
+func OrAny[T Table](
+	exprs ...TypedTableExpr[T, WrappedPrimitive[bool]],
+) TypedTableExpr[T, WrappedPrimitive[bool]] {
+	return logicalAll("OR", "FALSE", exprs)
+}
+
+func logicalAll[T Table](
+	operator string,
+	emptyQuery string,
+	exprs []TypedTableExpr[T, WrappedPrimitive[bool]],
+) TypedTableExpr[T, WrappedPrimitive[bool]] {
+	queries := make([]string, 0, len(exprs))
+	args := []ExprType{}
+	errs := []error{}
+	for _, expr := range exprs {
+		if expr == nil {
+			continue
+		}
+
+		if ec, ok := expr.(emptiableCondition); ok && ec.isEmpty() {
+			continue
+		}
+
+		query, exprArgs, exprErrs := expr.Expr()
+		if len(exprErrs) != 0 {
+			errs = append(errs, exprErrs...)
+			continue
+		}
+
+		queries = append(queries, query)
+		args = append(args, exprArgs...)
+	}
+
+	if len(errs) != 0 {
 		return &ExprStruct[T, WrappedPrimitive[bool]]{
-			errs: []error{errors.New("Assign: nil expression")},
+			errs: errs,
 		}
 	}
 
-	query1, args1, errs1 := expr1.Expr()
-	query2, args2, errs2 := expr2.Expr()
-	if len(errs1) != 0 || len(errs2) != 0 {
+	switch len(queries) {
+	case 0:
 		return &ExprStruct[T, WrappedPrimitive[bool]]{
-			errs: append(errs1, errs2...),
+			query: emptyQuery,
+		}
+	case 1:
+		return &ExprStruct[T, WrappedPrimitive[bool]]{
+			query: queries[0],
+			args:  args,
 		}
 	}
 
 	return &ExprStruct[T, WrappedPrimitive[bool]]{
-		query: fmt.Sprintf("(%s XOR %s)", query1, query2),
-		args:  append(args1, args2...),
+		query: fmt.Sprintf("(%s)", strings.Join(queries, fmt.Sprintf(" %s ", operator))),
+		args:  args,
 	}
 }
 
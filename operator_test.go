@@ -267,18 +267,20 @@ func TestAnd(t *testing.T) {
 			expectedArgs:  []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(2)},
 		},
 		{
-			description: "nil expr1",
-			expr1IsNil:  true,
-			expr2Query:  "(hoge.huga > ?)",
-			expr2Args:   []genorm.ExprType{genorm.Wrap(2)},
-			isError:     true,
+			description:   "nil expr1",
+			expr1IsNil:    true,
+			expr2Query:    "(hoge.huga > ?)",
+			expr2Args:     []genorm.ExprType{genorm.Wrap(2)},
+			expectedQuery: "(hoge.huga > ?)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(2)},
 		},
 		{
-			description: "nil expr2",
-			expr1Query:  "(hoge.huga = ?)",
-			expr1Args:   []genorm.ExprType{genorm.Wrap(1)},
-			expr2IsNil:  true,
-			isError:     true,
+			description:   "nil expr2",
+			expr1Query:    "(hoge.huga = ?)",
+			expr1Args:     []genorm.ExprType{genorm.Wrap(1)},
+			expr2IsNil:    true,
+			expectedQuery: "(hoge.huga = ?)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(1)},
 		},
 		{
 			description: "expr1 error",
@@ -325,12 +327,10 @@ func TestAnd(t *testing.T) {
 				mockExpr1 := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
 				expr1 = mockExpr1
 
-				if !test.expr2IsNil {
-					mockExpr1.
-						EXPECT().
-						Expr().
-						Return(test.expr1Query, test.expr1Args, test.expr1Errs)
-				}
+				mockExpr1.
+					EXPECT().
+					Expr().
+					Return(test.expr1Query, test.expr1Args, test.expr1Errs)
 			}
 
 			var expr2 genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]]
@@ -340,12 +340,10 @@ func TestAnd(t *testing.T) {
 				mockExpr2 := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
 				expr2 = mockExpr2
 
-				if !test.expr1IsNil {
-					mockExpr2.
-						EXPECT().
-						Expr().
-						Return(test.expr2Query, test.expr2Args, test.expr2Errs)
-				}
+				mockExpr2.
+					EXPECT().
+					Expr().
+					Return(test.expr2Query, test.expr2Args, test.expr2Errs)
 			}
 
 			res := genorm.And(expr1, expr2)
@@ -397,18 +395,20 @@ func TestOr(t *testing.T) {
 			expectedArgs:  []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(2)},
 		},
 		{
-			description: "nil expr1",
-			expr1IsNil:  true,
-			expr2Query:  "(hoge.huga > ?)",
-			expr2Args:   []genorm.ExprType{genorm.Wrap(2)},
-			isError:     true,
+			description:   "nil expr1",
+			expr1IsNil:    true,
+			expr2Query:    "(hoge.huga > ?)",
+			expr2Args:     []genorm.ExprType{genorm.Wrap(2)},
+			expectedQuery: "(hoge.huga > ?)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(2)},
 		},
 		{
-			description: "nil expr2",
-			expr1Query:  "(hoge.huga = ?)",
-			expr1Args:   []genorm.ExprType{genorm.Wrap(1)},
-			expr2IsNil:  true,
-			isError:     true,
+			description:   "nil expr2",
+			expr1Query:    "(hoge.huga = ?)",
+			expr1Args:     []genorm.ExprType{genorm.Wrap(1)},
+			expr2IsNil:    true,
+			expectedQuery: "(hoge.huga = ?)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(1)},
 		},
 		{
 			description: "expr1 error",
@@ -455,12 +455,10 @@ func TestOr(t *testing.T) {
 				mockExpr1 := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
 				expr1 = mockExpr1
 
-				if !test.expr2IsNil {
-					mockExpr1.
-						EXPECT().
-						Expr().
-						Return(test.expr1Query, test.expr1Args, test.expr1Errs)
-				}
+				mockExpr1.
+					EXPECT().
+					Expr().
+					Return(test.expr1Query, test.expr1Args, test.expr1Errs)
 			}
 
 			var expr2 genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]]
@@ -470,12 +468,10 @@ func TestOr(t *testing.T) {
 				mockExpr2 := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
 				expr2 = mockExpr2
 
-				if !test.expr1IsNil {
-					mockExpr2.
-						EXPECT().
-						Expr().
-						Return(test.expr2Query, test.expr2Args, test.expr2Errs)
-				}
+				mockExpr2.
+					EXPECT().
+					Expr().
+					Return(test.expr2Query, test.expr2Args, test.expr2Errs)
 			}
 
 			res := genorm.Or(expr1, expr2)
@@ -630,6 +626,222 @@ func TestXor(t *testing.T) {
 	}
 }
 
+func TestAndAll(t *testing.T) {
+	t.Parallel()
+
+	type expr struct {
+		isNil bool
+		query string
+		args  []genorm.ExprType
+		errs  []error
+	}
+
+	tests := []struct {
+		description   string
+		exprs         []expr
+		expectedQuery string
+		expectedArgs  []genorm.ExprType
+		isError       bool
+	}{
+		{
+			description: "normal",
+			exprs: []expr{
+				{query: "(hoge.huga = ?)", args: []genorm.ExprType{genorm.Wrap(1)}},
+				{query: "(hoge.huga > ?)", args: []genorm.ExprType{genorm.Wrap(2)}},
+				{query: "(hoge.piyo = hoge.piyo)"},
+			},
+			expectedQuery: "((hoge.huga = ?) AND (hoge.huga > ?) AND (hoge.piyo = hoge.piyo))",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(2)},
+		},
+		{
+			description: "nil exprs are skipped",
+			exprs: []expr{
+				{isNil: true},
+				{query: "(hoge.huga = ?)", args: []genorm.ExprType{genorm.Wrap(1)}},
+				{isNil: true},
+				{query: "(hoge.huga > ?)", args: []genorm.ExprType{genorm.Wrap(2)}},
+			},
+			expectedQuery: "((hoge.huga = ?) AND (hoge.huga > ?))",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(2)},
+		},
+		{
+			description: "single expr",
+			exprs: []expr{
+				{query: "(hoge.huga = ?)", args: []genorm.ExprType{genorm.Wrap(1)}},
+			},
+			expectedQuery: "(hoge.huga = ?)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(1)},
+		},
+		{
+			description:   "no exprs",
+			expectedQuery: "TRUE",
+		},
+		{
+			description: "only nil exprs",
+			exprs: []expr{
+				{isNil: true},
+				{isNil: true},
+			},
+			expectedQuery: "TRUE",
+		},
+		{
+			description: "expr error",
+			exprs: []expr{
+				{query: "(hoge.huga = ?)", args: []genorm.ExprType{genorm.Wrap(1)}},
+				{errs: []error{errors.New("expr error")}},
+			},
+			isError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			exprs := make([]genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]], 0, len(test.exprs))
+			for _, expr := range test.exprs {
+				if expr.isNil {
+					exprs = append(exprs, nil)
+					continue
+				}
+
+				mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return(expr.query, expr.args, expr.errs)
+
+				exprs = append(exprs, mockExpr)
+			}
+
+			res := genorm.AndAll(exprs...)
+
+			assert.NotNil(t, res)
+
+			query, args, errs := res.Expr()
+			if test.isError {
+				assert.NotEmpty(t, errs)
+				return
+			}
+
+			if !assert.Nil(t, errs) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
+
+func TestOrAny(t *testing.T) {
+	t.Parallel()
+
+	type expr struct {
+		isNil bool
+		query string
+		args  []genorm.ExprType
+		errs  []error
+	}
+
+	tests := []struct {
+		description   string
+		exprs         []expr
+		expectedQuery string
+		expectedArgs  []genorm.ExprType
+		isError       bool
+	}{
+		{
+			description: "normal",
+			exprs: []expr{
+				{query: "(hoge.huga = ?)", args: []genorm.ExprType{genorm.Wrap(1)}},
+				{query: "(hoge.huga > ?)", args: []genorm.ExprType{genorm.Wrap(2)}},
+				{query: "(hoge.piyo = hoge.piyo)"},
+			},
+			expectedQuery: "((hoge.huga = ?) OR (hoge.huga > ?) OR (hoge.piyo = hoge.piyo))",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(2)},
+		},
+		{
+			description: "nil exprs are skipped",
+			exprs: []expr{
+				{isNil: true},
+				{query: "(hoge.huga = ?)", args: []genorm.ExprType{genorm.Wrap(1)}},
+				{isNil: true},
+				{query: "(hoge.huga > ?)", args: []genorm.ExprType{genorm.Wrap(2)}},
+			},
+			expectedQuery: "((hoge.huga = ?) OR (hoge.huga > ?))",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(2)},
+		},
+		{
+			description: "single expr",
+			exprs: []expr{
+				{query: "(hoge.huga = ?)", args: []genorm.ExprType{genorm.Wrap(1)}},
+			},
+			expectedQuery: "(hoge.huga = ?)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(1)},
+		},
+		{
+			description:   "no exprs",
+			expectedQuery: "FALSE",
+		},
+		{
+			description: "only nil exprs",
+			exprs: []expr{
+				{isNil: true},
+				{isNil: true},
+			},
+			expectedQuery: "FALSE",
+		},
+		{
+			description: "expr error",
+			exprs: []expr{
+				{query: "(hoge.huga = ?)", args: []genorm.ExprType{genorm.Wrap(1)}},
+				{errs: []error{errors.New("expr error")}},
+			},
+			isError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			exprs := make([]genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]], 0, len(test.exprs))
+			for _, expr := range test.exprs {
+				if expr.isNil {
+					exprs = append(exprs, nil)
+					continue
+				}
+
+				mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return(expr.query, expr.args, expr.errs)
+
+				exprs = append(exprs, mockExpr)
+			}
+
+			res := genorm.OrAny(exprs...)
+
+			assert.NotNil(t, res)
+
+			query, args, errs := res.Expr()
+			if test.isError {
+				assert.NotEmpty(t, errs)
+				return
+			}
+
+			if !assert.Nil(t, errs) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
+
 func TestNot(t *testing.T) {
 	t.Parallel()
 
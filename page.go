@@ -0,0 +1,9 @@
+package genorm
+
+// Page result of offset pagination
+type Page[T any] struct {
+	Items []T
+	// Total number of records matching the query
+	Total   int64
+	HasNext bool
+}
@@ -0,0 +1,370 @@
+package genorm_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mazrean/genorm"
+	"github.com/mazrean/genorm/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectBuildCountQuery(t *testing.T) {
+	t.Parallel()
+
+	type expr struct {
+		query string
+		args  []genorm.ExprType
+		errs  []error
+	}
+
+	tests := []struct {
+		description     string
+		tableExpr       expr
+		distinct        bool
+		whereCondition  *expr
+		groupExpr       *expr
+		havingCondition *expr
+		orderBy         bool
+		limit           uint64
+		offset          uint64
+		lockType        genorm.LockType
+		query           string
+		args            []genorm.ExprType
+		err             bool
+	}{
+		{
+			description: "normal",
+			tableExpr: expr{
+				query: "hoge",
+			},
+			query: "SELECT COUNT(*) FROM hoge",
+			args:  []genorm.ExprType{},
+		},
+		{
+			description: "joined table",
+			tableExpr: expr{
+				query: "hoge JOIN fuga ON hoge.id = fuga.id AND hoge.huga = ?",
+				args:  []genorm.ExprType{genorm.Wrap(1)},
+			},
+			query: "SELECT COUNT(*) FROM hoge JOIN fuga ON hoge.id = fuga.id AND hoge.huga = ?",
+			args:  []genorm.ExprType{genorm.Wrap(1)},
+		},
+		{
+			description: "where",
+			tableExpr: expr{
+				query: "hoge",
+			},
+			whereCondition: &expr{
+				query: "(hoge.huga = ?)",
+				args:  []genorm.ExprType{genorm.Wrap(1)},
+			},
+			query: "SELECT COUNT(*) FROM hoge WHERE (hoge.huga = ?)",
+			args:  []genorm.ExprType{genorm.Wrap(1)},
+		},
+		{
+			description: "order by, limit, offset and lock are dropped",
+			tableExpr: expr{
+				query: "hoge",
+			},
+			whereCondition: &expr{
+				query: "(hoge.huga = ?)",
+				args:  []genorm.ExprType{genorm.Wrap(1)},
+			},
+			orderBy:  true,
+			limit:    10,
+			offset:   20,
+			lockType: genorm.ForUpdate,
+			query:    "SELECT COUNT(*) FROM hoge WHERE (hoge.huga = ?)",
+			args:     []genorm.ExprType{genorm.Wrap(1)},
+		},
+		{
+			description: "distinct",
+			tableExpr: expr{
+				query: "hoge",
+			},
+			distinct: true,
+			query:    "SELECT COUNT(*) FROM (SELECT DISTINCT hoge.huga AS hoge_huga_0 FROM hoge) AS genorm_count",
+			args:     []genorm.ExprType{},
+		},
+		{
+			description: "group by and having",
+			tableExpr: expr{
+				query: "hoge",
+			},
+			whereCondition: &expr{
+				query: "(hoge.huga = ?)",
+				args:  []genorm.ExprType{genorm.Wrap(1)},
+			},
+			groupExpr: &expr{
+				query: "hoge.huga",
+			},
+			havingCondition: &expr{
+				query: "(COUNT(hoge.huga) > ?)",
+				args:  []genorm.ExprType{genorm.Wrap(2)},
+			},
+			query: "SELECT COUNT(*) FROM (SELECT hoge.huga AS hoge_huga_0 FROM hoge WHERE (hoge.huga = ?) GROUP BY hoge.huga HAVING (COUNT(hoge.huga) > ?)) AS genorm_count",
+			args:  []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(2)},
+		},
+		{
+			description: "table error",
+			tableExpr: expr{
+				errs: []error{errors.New("table error")},
+			},
+			err: true,
+		},
+		{
+			description: "where error",
+			tableExpr: expr{
+				query: "hoge",
+			},
+			whereCondition: &expr{
+				errs: []error{errors.New("where error")},
+			},
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			table := mock.NewMockTable(ctrl)
+			table.
+				EXPECT().
+				Expr().
+				Return(test.tableExpr.query, test.tableExpr.args, test.tableExpr.errs)
+			table.
+				EXPECT().
+				GetErrors().
+				Return(nil)
+
+			mockColumn := mock.NewMockColumn(ctrl)
+			mockColumn.EXPECT().TableName().Return("hoge").AnyTimes()
+			mockColumn.EXPECT().ColumnName().Return("huga").AnyTimes()
+			mockColumn.EXPECT().SQLColumnName().Return("hoge.huga").AnyTimes()
+			table.
+				EXPECT().
+				Columns().
+				Return([]genorm.Column{mockColumn}).
+				AnyTimes()
+
+			builder := genorm.Select(table)
+
+			if test.distinct {
+				builder = builder.Distinct()
+			}
+
+			if test.whereCondition != nil {
+				mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return(test.whereCondition.query, test.whereCondition.args, test.whereCondition.errs)
+
+				builder = builder.Where(mockExpr)
+			}
+
+			if test.groupExpr != nil {
+				mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return(test.groupExpr.query, test.groupExpr.args, test.groupExpr.errs)
+
+				builder = builder.GroupBy(mockExpr)
+			}
+
+			if test.havingCondition != nil {
+				mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return(test.havingCondition.query, test.havingCondition.args, test.havingCondition.errs)
+
+				builder = builder.Having(mockExpr)
+			}
+
+			if test.orderBy {
+				builder = builder.OrderBy(genorm.Asc, mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl))
+			}
+
+			if test.limit != 0 {
+				builder = builder.Limit(test.limit)
+			}
+
+			if test.offset != 0 {
+				builder = builder.Offset(test.offset)
+			}
+
+			if test.lockType != 0 {
+				builder = builder.Lock(test.lockType)
+			}
+
+			query, args, err := builder.BuildCountQuery()
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+			assert.Equal(t, test.args, args)
+		})
+	}
+}
+
+func TestSelectGetPageArgs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		page        uint64
+		size        uint64
+		limit       uint64
+		offset      uint64
+	}{
+		{
+			description: "zero page",
+			page:        0,
+			size:        10,
+		},
+		{
+			description: "zero size",
+			page:        1,
+			size:        0,
+		},
+		{
+			description: "limit already set",
+			page:        1,
+			size:        10,
+			limit:       10,
+		},
+		{
+			description: "offset already set",
+			page:        1,
+			size:        10,
+			offset:      10,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			table := mock.NewMockTable(ctrl)
+			table.
+				EXPECT().
+				GetErrors().
+				Return(nil)
+
+			builder := genorm.Select(table)
+
+			if test.limit != 0 {
+				builder = builder.Limit(test.limit)
+			}
+
+			if test.offset != 0 {
+				builder = builder.Offset(test.offset)
+			}
+
+			_, err := builder.GetPage(nil, test.page, test.size)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSelectBuildPageQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		page        uint64
+		size        uint64
+		query       string
+		args        []genorm.ExprType
+		err         bool
+	}{
+		{
+			description: "first page",
+			page:        1,
+			size:        10,
+			query:       "SELECT hoge.huga AS hoge_huga_0 FROM hoge WHERE (hoge.huga = ?) LIMIT 10 OFFSET 0",
+			args:        []genorm.ExprType{genorm.Wrap(1)},
+		},
+		{
+			description: "third page",
+			page:        3,
+			size:        10,
+			query:       "SELECT hoge.huga AS hoge_huga_0 FROM hoge WHERE (hoge.huga = ?) LIMIT 10 OFFSET 20",
+			args:        []genorm.ExprType{genorm.Wrap(1)},
+		},
+		{
+			description: "too large offset",
+			page:        math.MaxUint64,
+			size:        1,
+			err:         true,
+		},
+		{
+			description: "zero page",
+			page:        0,
+			size:        10,
+			err:         true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			table := mock.NewMockTable(ctrl)
+			table.
+				EXPECT().
+				Expr().
+				Return("hoge", nil, nil).
+				AnyTimes()
+			table.
+				EXPECT().
+				GetErrors().
+				Return(nil)
+
+			mockColumn := mock.NewMockColumn(ctrl)
+			mockColumn.EXPECT().TableName().Return("hoge").AnyTimes()
+			mockColumn.EXPECT().ColumnName().Return("huga").AnyTimes()
+			mockColumn.EXPECT().SQLColumnName().Return("hoge.huga").AnyTimes()
+			table.
+				EXPECT().
+				Columns().
+				Return([]genorm.Column{mockColumn}).
+				AnyTimes()
+
+			mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+			mockExpr.
+				EXPECT().
+				Expr().
+				Return("(hoge.huga = ?)", []genorm.ExprType{genorm.Wrap(1)}, nil).
+				AnyTimes()
+
+			_, query, args, err := genorm.
+				Select(table).
+				Where(mockExpr).
+				BuildPageQuery(test.page, test.size)
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+			assert.Equal(t, test.args, args)
+		})
+	}
+}
@@ -19,6 +19,7 @@ type PluckContext[T Table, S ExprType] struct {
 	limit           limitClause
 	offset          offsetClause
 	lockType        lockClause
+	hint            hintClause
 }
 
 func Pluck[T Table, S ExprType](table T, field TypedTableExpr[T, S]) *PluckContext[T, S] {
@@ -28,7 +29,16 @@ func Pluck[T Table, S ExprType](table T, field TypedTableExpr[T, S]) *PluckConte
 	}
 }
 
+func (c *PluckContext[T, S]) clone() *PluckContext[T, S] {
+	clone := *c
+	clone.Context = c.Context.clone()
+
+	return &clone
+}
+
 func (c *PluckContext[T, S]) Distinct() *PluckContext[T, S] {
+	c = c.clone()
+
 	if c.distinct {
 		c.addError(errors.New("distinct already set"))
 		return c
@@ -42,6 +52,8 @@ func (c *PluckContext[T, S]) Distinct() *PluckContext[T, S] {
 func (c *PluckContext[T, S]) Where(
 	condition TypedTableExpr[T, WrappedPrimitive[bool]],
 ) *PluckContext[T, S] {
+	c = c.clone()
+
 	err := c.whereCondition.set(condition)
 	if err != nil {
 		c.addError(fmt.Errorf("where condition: %w", err))
@@ -50,7 +62,22 @@ func (c *PluckContext[T, S]) Where(
 	return c
 }
 
+func (c *PluckContext[T, S]) OrWhere(
+	condition TypedTableExpr[T, WrappedPrimitive[bool]],
+) *PluckContext[T, S] {
+	c = c.clone()
+
+	err := c.whereCondition.or(condition)
+	if err != nil {
+		c.addError(fmt.Errorf("where condition: %w", err))
+	}
+
+	return c
+}
+
 func (c *PluckContext[T, S]) GroupBy(exprs ...TableExpr[T]) *PluckContext[T, S] {
+	c = c.clone()
+
 	err := c.groupExpr.set(exprs)
 	if err != nil {
 		c.addError(fmt.Errorf("group by: %w", err))
@@ -62,6 +89,8 @@ func (c *PluckContext[T, S]) GroupBy(exprs ...TableExpr[T]) *PluckContext[T, S]
 func (c *PluckContext[T, S]) Having(
 	condition TypedTableExpr[T, WrappedPrimitive[bool]],
 ) *PluckContext[T, S] {
+	c = c.clone()
+
 	err := c.havingCondition.set(condition)
 	if err != nil {
 		c.addError(fmt.Errorf("having condition: %w", err))
@@ -71,6 +100,8 @@ func (c *PluckContext[T, S]) Having(
 }
 
 func (c *PluckContext[T, S]) OrderBy(direction OrderDirection, expr TableExpr[T]) *PluckContext[T, S] {
+	c = c.clone()
+
 	err := c.order.add(orderItem[T]{
 		expr:      expr,
 		direction: direction,
@@ -83,6 +114,8 @@ func (c *PluckContext[T, S]) OrderBy(direction OrderDirection, expr TableExpr[T]
 }
 
 func (c *PluckContext[T, S]) Limit(limit uint64) *PluckContext[T, S] {
+	c = c.clone()
+
 	err := c.limit.set(limit)
 	if err != nil {
 		c.addError(fmt.Errorf("limit: %w", err))
@@ -92,6 +125,8 @@ func (c *PluckContext[T, S]) Limit(limit uint64) *PluckContext[T, S] {
 }
 
 func (c *PluckContext[T, S]) Offset(offset uint64) *PluckContext[T, S] {
+	c = c.clone()
+
 	err := c.offset.set(offset)
 	if err != nil {
 		c.addError(fmt.Errorf("offset: %w", err))
@@ -100,8 +135,10 @@ func (c *PluckContext[T, S]) Offset(offset uint64) *PluckContext[T, S] {
 	return c
 }
 
-func (c *PluckContext[T, S]) Lock(lockType LockType) *PluckContext[T, S] {
-	err := c.lockType.set(lockType)
+func (c *PluckContext[T, S]) Lock(lockType LockType, options ...LockOption) *PluckContext[T, S] {
+	c = c.clone()
+
+	err := c.lockType.set(lockType, options...)
 	if err != nil {
 		c.addError(fmt.Errorf("lock: %w", err))
 	}
@@ -109,6 +146,38 @@ func (c *PluckContext[T, S]) Lock(lockType LockType) *PluckContext[T, S] {
 	return c
 }
 
+// IndexHint index hint for table in the FROM clause(MySQL only)
+func (c *PluckContext[T, S]) IndexHint(table BasicTable, hintType IndexHintType, indexes ...string) *PluckContext[T, S] {
+	c = c.clone()
+
+	err := c.hint.addIndexHint(table, hintType, indexes)
+	if err != nil {
+		c.addError(fmt.Errorf("index hint: %w", err))
+	}
+
+	return c
+}
+
+// OptimizerHint optimizer hint comment(/*+ hint */) after SELECT(MySQL only)
+func (c *PluckContext[T, S]) OptimizerHint(hint string) *PluckContext[T, S] {
+	c = c.clone()
+
+	err := c.hint.addOptimizerHint(hint)
+	if err != nil {
+		c.addError(fmt.Errorf("optimizer hint: %w", err))
+	}
+
+	return c
+}
+
+func (c *PluckContext[T, S]) Dialect(dialect Dialect) *PluckContext[T, S] {
+	c = c.clone()
+
+	c.setDialect(dialect)
+
+	return c
+}
+
 func (c *PluckContext[T, S]) GetAllCtx(ctx context.Context, db DB) ([]S, error) {
 	errs := c.Errors()
 	if len(errs) != 0 {
@@ -156,6 +225,7 @@ func (c *PluckContext[T, S]) GetAll(db DB) ([]S, error) {
 func (c *PluckContext[T, S]) GetCtx(ctx context.Context, db DB) (S, error) {
 	var res S
 
+	c = c.clone()
 	err := c.limit.set(1)
 	if err != nil {
 		return res, fmt.Errorf("set limit 1: %w", err)
@@ -194,6 +264,11 @@ func (c *PluckContext[T, S]) Get(db DB) (S, error) {
 }
 
 func (c *PluckContext[T, S]) buildQuery() (string, []ExprType, error) {
+	return c.buildAliasedQuery("res")
+}
+
+// buildAliasedQuery the query whose column is named columnAlias
+func (c *PluckContext[T, S]) buildAliasedQuery(columnAlias string) (string, []ExprType, error) {
 	sb := strings.Builder{}
 	args := []ExprType{}
 
@@ -203,6 +278,17 @@ func (c *PluckContext[T, S]) buildQuery() (string, []ExprType, error) {
 		return "", nil, fmt.Errorf("write select(%s): %w", str, err)
 	}
 
+	err = c.hint.validate(c.dialect)
+	if err != nil {
+		return "", nil, fmt.Errorf("hint: %w", err)
+	}
+
+	str = c.hint.getOptimizerHintExpr()
+	_, err = sb.WriteString(str)
+	if err != nil {
+		return "", nil, fmt.Errorf("write hint(%s): %w", str, err)
+	}
+
 	if c.distinct {
 		str = "DISTINCT "
 		_, err = sb.WriteString(str)
@@ -221,7 +307,7 @@ func (c *PluckContext[T, S]) buildQuery() (string, []ExprType, error) {
 		return "", nil, fmt.Errorf("write field(%s): %w", fieldQuery, err)
 	}
 
-	str = " AS res"
+	str = " AS " + columnAlias
 	_, err = sb.WriteString(str)
 	if err != nil {
 		return "", nil, fmt.Errorf("write as(%s): %w", str, err)
@@ -235,9 +321,19 @@ func (c *PluckContext[T, S]) buildQuery() (string, []ExprType, error) {
 		return "", nil, fmt.Errorf("write from(%s): %w", str, err)
 	}
 
-	tableQuery, tableArgs, errs := c.table.Expr()
-	if len(errs) != 0 {
-		return "", nil, fmt.Errorf("table expr: %w", errs[0])
+	indexHints, err := c.hint.newIndexHints()
+	if err != nil {
+		return "", nil, fmt.Errorf("index hint: %w", err)
+	}
+
+	tableQuery, tableArgs, err := c.indexHintTableExpr(indexHints)
+	if err != nil {
+		return "", nil, fmt.Errorf("table expr: %w", err)
+	}
+
+	err = indexHints.validate()
+	if err != nil {
+		return "", nil, fmt.Errorf("index hint: %w", err)
 	}
 
 	_, err = sb.WriteString(tableQuery)
@@ -368,6 +464,11 @@ func (c *PluckContext[T, S]) buildQuery() (string, []ExprType, error) {
 	}
 
 	if c.lockType.exists() {
+		err = c.lockType.validate(c.dialect, c.table)
+		if err != nil {
+			return "", nil, fmt.Errorf("lock type: %w", err)
+		}
+
 		lockQuery, lockArgs, err := c.lockType.getExpr()
 		if err != nil {
 			return "", nil, fmt.Errorf("lock type: %w", err)
@@ -387,5 +488,5 @@ func (c *PluckContext[T, S]) buildQuery() (string, []ExprType, error) {
 		args = append(args, lockArgs...)
 	}
 
-	return sb.String(), args, nil
+	return c.resolveDialectExprs(sb.String(), args)
 }
@@ -0,0 +1,292 @@
+package genorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+/*
+Relationship
+relationship between tables(table.key = ref_table.ref_key).
+e.g. has-many: users.id = messages.user_id, belongs-to: messages.user_id = users.id
+*/
+type Relationship[S any, T TablePointer[S], RS any, R TablePointer[RS], K ExprType] struct {
+	key    TypedTableColumns[T, K]
+	refKey TypedTableColumns[R, K]
+}
+
+func NewRelationship[S any, T TablePointer[S], RS any, R TablePointer[RS], K ExprType](
+	key TypedTableColumns[T, K],
+	refKey TypedTableColumns[R, K],
+) *Relationship[S, T, RS, R, K] {
+	return &Relationship[S, T, RS, R, K]{
+		key:    key,
+		refKey: refKey,
+	}
+}
+
+// Preloader relationship which can be preloaded by SelectContext.Preload
+type Preloader[T Table] interface {
+	preloadKey() (TableColumns[T], error)
+	// preload the ref tables of each table([]R per table)
+	preload(ctx context.Context, db DB, dialect Dialect, tables []T) ([]any, error)
+}
+
+// Preloaded table and the ref tables loaded by Preload. The ref tables are read by Relationship.Refs.
+type Preloaded[T Table] struct {
+	Table T
+	refs  map[any]any
+}
+
+// Refs ref tables of preloaded loaded by the relationship, nil if the relationship was not preloaded
+func (r *Relationship[S, T, RS, R, K]) Refs(preloaded *Preloaded[T]) []R {
+	if preloaded == nil {
+		return nil
+	}
+
+	refs, ok := preloaded.refs[r].([]R)
+	if !ok {
+		return nil
+	}
+
+	return refs
+}
+
+// preloadChunkSize max number of keys in an IN clause of the ref query
+const preloadChunkSize = 1000
+
+type PreloadContext[S any, T TablePointer[S]] struct {
+	selectContext *SelectContext[S, T]
+	preloaders    []Preloader[T]
+}
+
+/*
+Preload
+run the query and the queries of the ref tables of each relationship(SELECT ... FROM ref_table WHERE ref_key IN (...)),
+then attach the ref tables to each table.
+*/
+func (c *SelectContext[S, T]) Preload(preloaders ...Preloader[T]) *PreloadContext[S, T] {
+	return &PreloadContext[S, T]{
+		selectContext: c,
+		preloaders:    preloaders,
+	}
+}
+
+// Preload add relationships to preload
+func (c *PreloadContext[S, T]) Preload(preloaders ...Preloader[T]) *PreloadContext[S, T] {
+	return &PreloadContext[S, T]{
+		selectContext: c.selectContext,
+		preloaders:    append(slices.Clip(c.preloaders), preloaders...),
+	}
+}
+
+func (c *PreloadContext[S, T]) GetAllCtx(ctx context.Context, db DB) ([]*Preloaded[T], error) {
+	selectContext, err := c.tableContext()
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := selectContext.GetAllCtx(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("select tables: %w", err)
+	}
+
+	preloadedTables := make([]*Preloaded[T], 0, len(tables))
+	for _, table := range tables {
+		preloadedTables = append(preloadedTables, &Preloaded[T]{
+			Table: table,
+			refs:  make(map[any]any, len(c.preloaders)),
+		})
+	}
+
+	for _, preloader := range c.preloaders {
+		refs, err := preloader.preload(ctx, db, selectContext.dialect, tables)
+		if err != nil {
+			return nil, fmt.Errorf("preload: %w", err)
+		}
+
+		for i, preloadedTable := range preloadedTables {
+			preloadedTable.refs[preloader] = refs[i]
+		}
+	}
+
+	return preloadedTables, nil
+}
+
+func (c *PreloadContext[S, T]) GetAll(db DB) ([]*Preloaded[T], error) {
+	return c.GetAllCtx(context.Background(), db)
+}
+
+// tableContext select context of the tables, which selects the key columns
+func (c *PreloadContext[S, T]) tableContext() (*SelectContext[S, T], error) {
+	if c.selectContext == nil {
+		return nil, errors.New("nil select context")
+	}
+	if len(c.preloaders) == 0 {
+		return nil, errors.New("no relationship to preload")
+	}
+
+	selectContext := c.selectContext
+	for _, preloader := range c.preloaders {
+		if preloader == nil {
+			return nil, errors.New("invalid relationship")
+		}
+
+		key, err := preloader.preloadKey()
+		if err != nil {
+			return nil, err
+		}
+
+		if selectContext.fields == nil || slices.Contains(selectContext.fields, key) {
+			continue
+		}
+
+		selectContext = selectContext.clone()
+		selectContext.fields = append(slices.Clip(selectContext.fields), key)
+	}
+
+	return selectContext, nil
+}
+
+func (r *Relationship[S, T, RS, R, K]) preloadKey() (TableColumns[T], error) {
+	if r == nil || r.key == nil || r.refKey == nil {
+		return nil, errors.New("invalid relationship")
+	}
+
+	return r.key, nil
+}
+
+func (r *Relationship[S, T, RS, R, K]) preload(ctx context.Context, db DB, dialect Dialect, tables []T) ([]any, error) {
+	keys, err := r.keys(tables)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := []R{}
+	for chunk := range slices.Chunk(keys, preloadChunkSize) {
+		chunkRefs, err := r.refContext(dialect, chunk).GetAllCtx(ctx, db)
+		if err != nil {
+			return nil, fmt.Errorf("select ref tables: %w", err)
+		}
+
+		refs = append(refs, chunkRefs...)
+	}
+
+	tableRefs, err := r.tableRefs(tables, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	anyRefs := make([]any, 0, len(tableRefs))
+	for _, refs := range tableRefs {
+		anyRefs = append(anyRefs, refs)
+	}
+
+	return anyRefs, nil
+}
+
+// keys unique non-NULL values of the key column in tables
+func (r *Relationship[S, T, RS, R, K]) keys(tables []T) ([]K, error) {
+	keys := []K{}
+	keyMap := map[any]struct{}{}
+	for _, table := range tables {
+		key, mapKey, ok, err := columnValue[K](table, r.key)
+		if err != nil {
+			return nil, fmt.Errorf("key: %w", err)
+		}
+		if !ok {
+			continue
+		}
+
+		if _, ok := keyMap[mapKey]; ok {
+			continue
+		}
+
+		keyMap[mapKey] = struct{}{}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// refContext SELECT ... FROM ref_table WHERE ref_key IN (keys...)
+func (r *Relationship[S, T, RS, R, K]) refContext(dialect Dialect, keys []K) *SelectContext[RS, R] {
+	var refTable RS
+
+	return Select(R(&refTable)).
+		Dialect(dialect).
+		Where(InLit[R, K](r.refKey, keys...))
+}
+
+// tableRefs refs whose ref key equals the key of each table
+func (r *Relationship[S, T, RS, R, K]) tableRefs(tables []T, refs []R) ([][]R, error) {
+	refMap := map[any][]R{}
+	for _, ref := range refs {
+		_, mapKey, ok, err := columnValue[K](ref, r.refKey)
+		if err != nil {
+			return nil, fmt.Errorf("ref key: %w", err)
+		}
+		if !ok {
+			continue
+		}
+
+		refMap[mapKey] = append(refMap[mapKey], ref)
+	}
+
+	tableRefs := make([][]R, 0, len(tables))
+	for _, table := range tables {
+		_, mapKey, ok, err := columnValue[K](table, r.key)
+		if err != nil {
+			return nil, fmt.Errorf("key: %w", err)
+		}
+
+		refs := []R{}
+		if ok {
+			refs = append(refs, refMap[mapKey]...)
+		}
+
+		tableRefs = append(tableRefs, refs)
+	}
+
+	return tableRefs, nil
+}
+
+/*
+columnValue
+value of column in table, and the key of the value for maps.
+ok is false if the value is NULL.
+*/
+func columnValue[K ExprType](table Table, column Column) (K, any, bool, error) {
+	var zero K
+
+	columnField, ok := table.ColumnMap()[column.SQLColumnName()]
+	if !ok {
+		return zero, nil, false, fmt.Errorf("column %s not found", column.SQLColumnName())
+	}
+
+	value, ok := any(columnField).(*K)
+	if !ok {
+		return zero, nil, false, fmt.Errorf("column %s is not %T", column.SQLColumnName(), zero)
+	}
+
+	driverValue, err := columnField.Value()
+	if errors.Is(err, ErrNullValue) {
+		return zero, nil, false, nil
+	}
+	if err != nil {
+		return zero, nil, false, fmt.Errorf("column %s value: %w", column.SQLColumnName(), err)
+	}
+
+	var mapKey any = driverValue
+	switch v := driverValue.(type) {
+	case []byte:
+		// []byte is not comparable
+		mapKey = string(v)
+	case nil:
+		return zero, nil, false, nil
+	}
+
+	return *value, mapKey, true, nil
+}
@@ -0,0 +1,29 @@
+package genorm
+
+func (c *PreloadContext[S, T]) TableContext() (*SelectContext[S, T], error) {
+	return c.tableContext()
+}
+
+func (r *Relationship[_, T, _, _, K]) Keys(tables []T) ([]K, error) {
+	return r.keys(tables)
+}
+
+func (r *Relationship[_, _, RS, R, K]) RefContext(keys []K) *SelectContext[RS, R] {
+	return r.refContext(MySQL, keys)
+}
+
+func (r *Relationship[_, T, _, R, _]) TableRefs(tables []T, refs []R) ([][]R, error) {
+	return r.tableRefs(tables, refs)
+}
+
+func NewPreloaded[T Table](table T, refs map[Preloader[T]]any) *Preloaded[T] {
+	preloaded := &Preloaded[T]{
+		Table: table,
+		refs:  make(map[any]any, len(refs)),
+	}
+	for preloader, ref := range refs {
+		preloaded.refs[preloader] = ref
+	}
+
+	return preloaded
+}
@@ -0,0 +1,267 @@
+package genorm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mazrean/genorm"
+	"github.com/stretchr/testify/assert"
+)
+
+type preloadTestColumn[T genorm.Table, S genorm.ExprType] struct {
+	tableName  string
+	columnName string
+}
+
+func (c preloadTestColumn[_, _]) Expr() (string, []genorm.ExprType, []error) {
+	return c.SQLColumnName(), nil, nil
+}
+
+func (c preloadTestColumn[_, _]) SQLColumnName() string {
+	return fmt.Sprintf("%s.%s", c.tableName, c.columnName)
+}
+
+func (c preloadTestColumn[_, _]) TableName() string {
+	return c.tableName
+}
+
+func (c preloadTestColumn[_, _]) ColumnName() string {
+	return c.columnName
+}
+
+func (c preloadTestColumn[T, _]) TableExpr(T) (string, []genorm.ExprType, []error) {
+	return c.Expr()
+}
+
+func (c preloadTestColumn[_, S]) TypedExpr(S) (string, []genorm.ExprType, []error) {
+	return c.Expr()
+}
+
+type preloadTestMessage struct {
+	ID     genorm.WrappedPrimitive[int64]
+	UserID genorm.WrappedPrimitive[int64]
+}
+
+var (
+	preloadTestMessageID     genorm.TypedTableColumns[*preloadTestMessage, genorm.WrappedPrimitive[int64]] = preloadTestColumn[*preloadTestMessage, genorm.WrappedPrimitive[int64]]{tableName: "messages", columnName: "id"}
+	preloadTestMessageUserID genorm.TypedTableColumns[*preloadTestMessage, genorm.WrappedPrimitive[int64]] = preloadTestColumn[*preloadTestMessage, genorm.WrappedPrimitive[int64]]{tableName: "messages", columnName: "user_id"}
+)
+
+func (*preloadTestMessage) TableName() string {
+	return "messages"
+}
+
+func (t *preloadTestMessage) Expr() (string, []genorm.ExprType, []error) {
+	return t.TableName(), nil, nil
+}
+
+func (*preloadTestMessage) Columns() []genorm.Column {
+	return []genorm.Column{preloadTestMessageID, preloadTestMessageUserID}
+}
+
+func (t *preloadTestMessage) ColumnMap() map[string]genorm.ColumnFieldExprType {
+	return map[string]genorm.ColumnFieldExprType{
+		preloadTestMessageID.SQLColumnName():     &t.ID,
+		preloadTestMessageUserID.SQLColumnName(): &t.UserID,
+	}
+}
+
+func (*preloadTestMessage) GetErrors() []error {
+	return nil
+}
+
+func TestPreloadTableContext(t *testing.T) {
+	t.Parallel()
+
+	relationship := genorm.NewRelationship(aliasTestUserID, preloadTestMessageUserID)
+
+	tests := []struct {
+		description   string
+		selectContext *genorm.SelectContext[aliasTestUser, *aliasTestUser]
+		preloaders    []genorm.Preloader[*aliasTestUser]
+		query         string
+		isError       bool
+	}{
+		{
+			description:   "all columns",
+			selectContext: genorm.Select(&aliasTestUser{}),
+			preloaders:    []genorm.Preloader[*aliasTestUser]{relationship},
+			query:         "SELECT users.id AS users_id_0 FROM users",
+		},
+		{
+			description:   "key column selected",
+			selectContext: genorm.Select(&aliasTestUser{}).Fields(aliasTestUserID),
+			preloaders:    []genorm.Preloader[*aliasTestUser]{relationship},
+			query:         "SELECT users.id AS users_id_0 FROM users",
+		},
+		{
+			description:   "no relationship",
+			selectContext: genorm.Select(&aliasTestUser{}),
+			isError:       true,
+		},
+		{
+			description:   "nil relationship",
+			selectContext: genorm.Select(&aliasTestUser{}),
+			preloaders:    []genorm.Preloader[*aliasTestUser]{nil},
+			isError:       true,
+		},
+		{
+			description:   "nil typed relationship",
+			selectContext: genorm.Select(&aliasTestUser{}),
+			preloaders: []genorm.Preloader[*aliasTestUser]{
+				(*genorm.Relationship[aliasTestUser, *aliasTestUser, preloadTestMessage, *preloadTestMessage, genorm.WrappedPrimitive[int64]])(nil),
+			},
+			isError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			selectContext, err := test.selectContext.Preload(test.preloaders...).TableContext()
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			_, query, _, err := selectContext.BuildQuery()
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+		})
+	}
+}
+
+func TestPreloadTableContextAddKey(t *testing.T) {
+	t.Parallel()
+
+	// messages.user_id = users.id(belongs-to)
+	relationship := genorm.NewRelationship(preloadTestMessageUserID, aliasTestUserID)
+	// messages.id = users.id
+	idRelationship := genorm.NewRelationship(preloadTestMessageID, aliasTestUserID)
+	selectContext := genorm.Select(&preloadTestMessage{}).Fields(preloadTestMessageID)
+
+	preloadSelectContext, err := selectContext.Preload(relationship).Preload(idRelationship).TableContext()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, query, _, err := preloadSelectContext.BuildQuery()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "SELECT messages.id AS messages_id_0, messages.user_id AS messages_user_id_0 FROM messages", query)
+
+	// the original select context is not changed
+	_, query, _, err = selectContext.BuildQuery()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "SELECT messages.id AS messages_id_0 FROM messages", query)
+}
+
+func TestPreloadKeys(t *testing.T) {
+	t.Parallel()
+
+	relationship := genorm.NewRelationship(aliasTestUserID, preloadTestMessageUserID)
+
+	keys, err := relationship.Keys([]*aliasTestUser{
+		{ID: genorm.Wrap[int64](1)},
+		{ID: genorm.Wrap[int64](2)},
+		{ID: genorm.Wrap[int64](1)},
+		{},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []genorm.WrappedPrimitive[int64]{genorm.Wrap[int64](1), genorm.Wrap[int64](2)}, keys)
+
+	_, query, args, err := relationship.RefContext(keys).BuildQuery()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "SELECT messages.id AS messages_id_0, messages.user_id AS messages_user_id_0 FROM messages WHERE (messages.user_id IN (?, ?))", query)
+	assert.Equal(t, []genorm.ExprType{genorm.Wrap[int64](1), genorm.Wrap[int64](2)}, args)
+}
+
+func TestPreloadTableRefs(t *testing.T) {
+	t.Parallel()
+
+	relationship := genorm.NewRelationship(aliasTestUserID, preloadTestMessageUserID)
+
+	users := []*aliasTestUser{
+		{ID: genorm.Wrap[int64](1)},
+		{ID: genorm.Wrap[int64](2)},
+		{},
+	}
+	messages := []*preloadTestMessage{
+		{ID: genorm.Wrap[int64](1), UserID: genorm.Wrap[int64](1)},
+		{ID: genorm.Wrap[int64](2), UserID: genorm.Wrap[int64](1)},
+		{ID: genorm.Wrap[int64](3)},
+	}
+
+	tableRefs, err := relationship.TableRefs(users, messages)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, [][]*preloadTestMessage{
+		{messages[0], messages[1]},
+		{},
+		{},
+	}, tableRefs)
+}
+
+func TestRelationshipRefs(t *testing.T) {
+	t.Parallel()
+
+	relationship := genorm.NewRelationship(aliasTestUserID, preloadTestMessageUserID)
+	otherRelationship := genorm.NewRelationship(aliasTestUserID, preloadTestMessageID)
+
+	messages := []*preloadTestMessage{
+		{ID: genorm.Wrap[int64](1), UserID: genorm.Wrap[int64](1)},
+	}
+
+	tests := []struct {
+		description string
+		preloaded   *genorm.Preloaded[*aliasTestUser]
+		refs        []*preloadTestMessage
+	}{
+		{
+			description: "preloaded",
+			preloaded: genorm.NewPreloaded(&aliasTestUser{}, map[genorm.Preloader[*aliasTestUser]]any{
+				relationship: messages,
+			}),
+			refs: messages,
+		},
+		{
+			description: "other relationship preloaded",
+			preloaded: genorm.NewPreloaded(&aliasTestUser{}, map[genorm.Preloader[*aliasTestUser]]any{
+				otherRelationship: messages,
+			}),
+			refs: nil,
+		},
+		{
+			description: "nil preloaded",
+			preloaded:   nil,
+			refs:        nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.refs, relationship.Refs(test.preloaded))
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package relation
+
+import (
+	"github.com/mazrean/genorm"
+)
+
+type BasicTablePointer[T any] interface {
+	BasicTable
+	*T
+}
+
+/*
+PairTable
+joined table of two tables.
+Use it to join tables that the generated joined tables do not cover,
+e.g. self-joins with genorm.AliasedTable.
+*/
+type PairTable[L any, LP BasicTablePointer[L], R any, RP BasicTablePointer[R]] struct {
+	left       L
+	right      R
+	relation   *Relation
+	nullTables genorm.NullTables
+	errs       []error
+}
+
+type PairContext[L any, LP BasicTablePointer[L], R any, RP BasicTablePointer[R]] = RelationContext[LP, RP, *PairTable[L, LP, R, RP], PairTable[L, LP, R, RP]]
+
+// Pair relation context of left and right
+func Pair[L any, LP BasicTablePointer[L], R any, RP BasicTablePointer[R]](left LP, right RP) *PairContext[L, LP, R, RP] {
+	return NewRelationContext[LP, RP, *PairTable[L, LP, R, RP], PairTable[L, LP, R, RP]](left, right)
+}
+
+// Left left table
+func (pt *PairTable[L, LP, _, _]) Left() LP {
+	return LP(&pt.left)
+}
+
+// Right right table
+func (pt *PairTable[_, _, R, RP]) Right() RP {
+	return RP(&pt.right)
+}
+
+// NullableLeft left table, and false if it is NULL by RIGHT JOIN or FULL OUTER JOIN in the row scanned by Select
+func (pt *PairTable[L, LP, _, _]) NullableLeft() (LP, bool) {
+	return LP(&pt.left), !pt.nullTables.IsNull(LP(&pt.left))
+}
+
+// NullableRight right table, and false if it is NULL by LEFT JOIN or FULL OUTER JOIN in the row scanned by Select
+func (pt *PairTable[_, _, R, RP]) NullableRight() (RP, bool) {
+	return RP(&pt.right), !pt.nullTables.IsNull(RP(&pt.right))
+}
+
+func (pt *PairTable[_, _, _, _]) Expr() (string, []genorm.ExprType, []error) {
+	return pt.relation.JoinedTableName()
+}
+
+func (pt *PairTable[_, _, _, _]) IndexHintExpr(hints *genorm.IndexHints) (string, []genorm.ExprType, []error) {
+	return pt.relation.IndexHintExpr(hints)
+}
+
+func (pt *PairTable[L, LP, R, RP]) Columns() []genorm.Column {
+	return append(LP(&pt.left).Columns(), RP(&pt.right).Columns()...)
+}
+
+func (pt *PairTable[L, LP, R, RP]) ColumnMap() map[string]genorm.ColumnFieldExprType {
+	columnMap := map[string]genorm.ColumnFieldExprType{}
+	for k, expr := range LP(&pt.left).ColumnMap() {
+		columnMap[k] = expr
+	}
+	for k, expr := range RP(&pt.right).ColumnMap() {
+		columnMap[k] = expr
+	}
+
+	return columnMap
+}
+
+func (pt *PairTable[L, LP, R, RP]) BaseTables() []genorm.BasicTable {
+	return []genorm.BasicTable{LP(&pt.left), RP(&pt.right)}
+}
+
+func (pt *PairTable[_, _, _, _]) GetErrors() []error {
+	return pt.errs
+}
+
+func (pt *PairTable[_, _, _, _]) AddError(err error) {
+	pt.errs = append(pt.errs, err)
+}
+
+func (pt *PairTable[_, _, _, _]) SetRelation(relation *Relation) {
+	pt.relation = relation
+}
+
+func (pt *PairTable[_, _, _, _]) ValidateDialect(dialect genorm.Dialect) error {
+	return pt.relation.ValidateDialect(dialect)
+}
+
+func (pt *PairTable[_, _, _, _]) NullableTables() []genorm.BasicTable {
+	return pt.relation.NullableTables()
+}
+
+func (pt *PairTable[_, _, _, _]) SetNull(table genorm.BasicTable) {
+	pt.nullTables.SetNull(table)
+}
+
+func (pt *PairTable[_, _, _, _]) Detach(target genorm.BasicTable) (genorm.Table, genorm.Expr, error) {
+	return pt.relation.Detach(target)
+}
+
+type pairColumn[P genorm.Table, T genorm.ExprType] struct {
+	column genorm.Column
+}
+
+func (pc pairColumn[_, _]) Expr() (string, []genorm.ExprType, []error) {
+	return pc.column.Expr()
+}
+
+func (pc pairColumn[_, _]) SQLColumnName() string {
+	return pc.column.SQLColumnName()
+}
+
+func (pc pairColumn[_, _]) TableName() string {
+	return pc.column.TableName()
+}
+
+func (pc pairColumn[_, _]) ColumnName() string {
+	return pc.column.ColumnName()
+}
+
+func (pc pairColumn[P, _]) TableExpr(P) (string, []genorm.ExprType, []error) {
+	return pc.Expr()
+}
+
+func (pc pairColumn[_, T]) TypedExpr(T) (string, []genorm.ExprType, []error) {
+	return pc.Expr()
+}
+
+// Left column of the left table as the column of the pair table
+func Left[L any, LP BasicTablePointer[L], R any, RP BasicTablePointer[R], T genorm.ExprType](
+	_ *PairContext[L, LP, R, RP],
+	column genorm.TypedTableColumns[LP, T],
+) genorm.TypedTableColumns[*PairTable[L, LP, R, RP], T] {
+	return pairColumn[*PairTable[L, LP, R, RP], T]{column: column}
+}
+
+// LeftExpr column of the left table as the expression of the pair table
+func LeftExpr[L any, LP BasicTablePointer[L], R any, RP BasicTablePointer[R], T genorm.ExprType](
+	_ *PairContext[L, LP, R, RP],
+	column genorm.TypedTableColumns[LP, T],
+) genorm.TypedTableExpr[*PairTable[L, LP, R, RP], T] {
+	return pairColumn[*PairTable[L, LP, R, RP], T]{column: column}
+}
+
+// Right column of the right table as the column of the pair table
+func Right[L any, LP BasicTablePointer[L], R any, RP BasicTablePointer[R], T genorm.ExprType](
+	_ *PairContext[L, LP, R, RP],
+	column genorm.TypedTableColumns[RP, T],
+) genorm.TypedTableColumns[*PairTable[L, LP, R, RP], T] {
+	return pairColumn[*PairTable[L, LP, R, RP], T]{column: column}
+}
+
+// RightExpr column of the right table as the expression of the pair table
+func RightExpr[L any, LP BasicTablePointer[L], R any, RP BasicTablePointer[R], T genorm.ExprType](
+	_ *PairContext[L, LP, R, RP],
+	column genorm.TypedTableColumns[RP, T],
+) genorm.TypedTableExpr[*PairTable[L, LP, R, RP], T] {
+	return pairColumn[*PairTable[L, LP, R, RP], T]{column: column}
+}
+
+// LeftNullable column of the left table, which can be NULL by RIGHT JOIN or FULL OUTER JOIN
+func LeftNullable[L any, LP BasicTablePointer[L], R any, RP BasicTablePointer[R], T genorm.ExprType](
+	pair *PairContext[L, LP, R, RP],
+	column genorm.TypedTableColumns[LP, T],
+) genorm.TypedTableColumns[*PairTable[L, LP, R, RP], genorm.Nullable[T]] {
+	return genorm.NullableColumn(Left(pair, column))
+}
+
+// LeftNullableExpr column of the left table as the expression which can be NULL
+func LeftNullableExpr[L any, LP BasicTablePointer[L], R any, RP BasicTablePointer[R], T genorm.ExprType](
+	pair *PairContext[L, LP, R, RP],
+	column genorm.TypedTableColumns[LP, T],
+) genorm.TypedTableExpr[*PairTable[L, LP, R, RP], genorm.Nullable[T]] {
+	return genorm.NullableColumn(Left(pair, column))
+}
+
+// RightNullable column of the right table, which can be NULL by LEFT JOIN or FULL OUTER JOIN
+func RightNullable[L any, LP BasicTablePointer[L], R any, RP BasicTablePointer[R], T genorm.ExprType](
+	pair *PairContext[L, LP, R, RP],
+	column genorm.TypedTableColumns[RP, T],
+) genorm.TypedTableColumns[*PairTable[L, LP, R, RP], genorm.Nullable[T]] {
+	return genorm.NullableColumn(Right(pair, column))
+}
+
+// RightNullableExpr column of the right table as the expression which can be NULL
+func RightNullableExpr[L any, LP BasicTablePointer[L], R any, RP BasicTablePointer[R], T genorm.ExprType](
+	pair *PairContext[L, LP, R, RP],
+	column genorm.TypedTableColumns[RP, T],
+) genorm.TypedTableExpr[*PairTable[L, LP, R, RP], genorm.Nullable[T]] {
+	return genorm.NullableColumn(Right(pair, column))
+}
@@ -9,7 +9,7 @@ import (
 )
 
 //nolint:revive
-type RelationContext[S Table, T Table, _ JoinedTablePointer[V], V any] struct {
+type RelationContext[S Table, T Table, U JoinedTablePointer[V], V any] struct {
 	baseTable S
 	refTable  T
 }
@@ -21,30 +21,121 @@ func NewRelationContext[S Table, T Table, U JoinedTablePointer[V], V any](baseTa
 	}
 }
 
-// Join INNER JOIN(CROSS JOIN)
-func (r *RelationContext[S, T, U, V]) Join(
-	expr genorm.TypedTableExpr[U, genorm.WrappedPrimitive[bool]],
-) U {
-	var joinedTable V
+/*
+FKRelationContext
+RelationContext of tables related by a foreign key.
+The generated code returns this if the foreign key is declared in the genorm.Ref tag.
+*/
+type FKRelationContext[S Table, T Table, U JoinedTablePointer[V], V any] struct {
+	*RelationContext[S, T, U, V]
+	foreignKeyExpr genorm.TypedTableExpr[U, genorm.WrappedPrimitive[bool]]
+}
 
-	relation, err := newRelation(join, r.baseTable, r.refTable, expr)
-	if err != nil {
-		U(&joinedTable).AddError(err)
-		return &joinedTable
+// WithForeignKey set the condition of the foreign key used by JoinOnFK
+func (r *RelationContext[S, T, U, V]) WithForeignKey(
+	expr genorm.TypedTableExpr[U, genorm.WrappedPrimitive[bool]],
+) *FKRelationContext[S, T, U, V] {
+	return &FKRelationContext[S, T, U, V]{
+		RelationContext: r,
+		foreignKeyExpr:  expr,
 	}
+}
 
-	U(&joinedTable).SetRelation(relation)
+// JoinOnFK INNER JOIN ON the foreign key
+func (r *FKRelationContext[S, T, U, V]) JoinOnFK() U {
+	return r.join(join, r.foreignKeyExpr, nil)
+}
 
-	return &joinedTable
+// Join INNER JOIN
+func (r *RelationContext[S, T, U, V]) Join(
+	expr genorm.TypedTableExpr[U, genorm.WrappedPrimitive[bool]],
+) U {
+	return r.join(join, expr, nil)
 }
 
 // LeftJoin LEFT JOIN
 func (r *RelationContext[S, T, U, V]) LeftJoin(
 	expr genorm.TypedTableExpr[U, genorm.WrappedPrimitive[bool]],
 ) U {
+	return r.join(leftJoin, expr, nil)
+}
+
+// RightJoin RIGHT JOIN
+func (r *RelationContext[S, T, U, V]) RightJoin(
+	expr genorm.TypedTableExpr[U, genorm.WrappedPrimitive[bool]],
+) U {
+	return r.join(rightJoin, expr, nil)
+}
+
+// FullJoin FULL OUTER JOIN(PostgreSQL only)
+func (r *RelationContext[S, T, U, V]) FullJoin(
+	expr genorm.TypedTableExpr[U, genorm.WrappedPrimitive[bool]],
+) U {
+	return r.join(fullJoin, expr, nil)
+}
+
+// CrossJoin CROSS JOIN
+func (r *RelationContext[S, T, U, V]) CrossJoin() U {
+	return r.join(crossJoin, nil, nil)
+}
+
+/*
+LateralJoin INNER JOIN LATERAL
+refTable must be a genorm.LateralTable(e.g. genorm.DerivedTable).
+*/
+func (r *RelationContext[S, T, U, V]) LateralJoin(
+	expr genorm.TypedTableExpr[U, genorm.WrappedPrimitive[bool]],
+) U {
+	return r.lateralJoin(join, expr)
+}
+
+/*
+LeftLateralJoin LEFT JOIN LATERAL
+refTable must be a genorm.LateralTable(e.g. genorm.DerivedTable).
+*/
+func (r *RelationContext[S, T, U, V]) LeftLateralJoin(
+	expr genorm.TypedTableExpr[U, genorm.WrappedPrimitive[bool]],
+) U {
+	return r.lateralJoin(leftJoin, expr)
+}
+
+/*
+CrossLateralJoin CROSS JOIN LATERAL
+refTable must be a genorm.LateralTable(e.g. genorm.DerivedTable).
+*/
+func (r *RelationContext[S, T, U, V]) CrossLateralJoin() U {
+	return r.lateralJoin(crossJoin, nil)
+}
+
+/*
+JoinUsing INNER JOIN ... USING (column_name1, column_name2, ...)
+columns must be in both the base table and the ref table.
+*/
+func (r *RelationContext[S, T, U, V]) JoinUsing(columns ...genorm.TableColumns[S]) U {
+	if len(columns) == 0 {
+		var joinedTable V
+		U(&joinedTable).AddError(errors.New("no using columns"))
+		return &joinedTable
+	}
+
+	columnNames := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if column == nil {
+			var joinedTable V
+			U(&joinedTable).AddError(errors.New("nil using column"))
+			return &joinedTable
+		}
+
+		columnNames = append(columnNames, column.ColumnName())
+	}
+
+	return r.join(join, nil, columnNames)
+}
+
+func (r *RelationContext[S, T, U, V]) join(relationType RelationType, expr genorm.Expr, usingColumns []string) U {
 	var joinedTable V
 
-	relation, err := newRelation(leftJoin, r.baseTable, r.refTable, expr)
+	relation, err := newRelation(relationType, r.baseTable, r.refTable, expr, usingColumns)
 	if err != nil {
 		U(&joinedTable).AddError(err)
 		return &joinedTable
@@ -55,18 +146,22 @@ func (r *RelationContext[S, T, U, V]) LeftJoin(
 	return &joinedTable
 }
 
-// RightJoin RIGHT JOIN
-func (r *RelationContext[S, T, U, V]) RightJoin(
-	expr genorm.TypedTableExpr[U, genorm.WrappedPrimitive[bool]],
-) U {
+func (r *RelationContext[S, T, U, V]) lateralJoin(relationType RelationType, expr genorm.Expr) U {
 	var joinedTable V
 
-	relation, err := newRelation(rightJoin, r.baseTable, r.refTable, expr)
+	relation, err := newRelation(relationType, r.baseTable, r.refTable, expr, nil)
 	if err != nil {
 		U(&joinedTable).AddError(err)
 		return &joinedTable
 	}
 
+	if _, ok := any(r.refTable).(genorm.LateralTable); !ok {
+		U(&joinedTable).AddError(fmt.Errorf("lateral join with %T, which is not a subquery", r.refTable))
+		return &joinedTable
+	}
+
+	relation.lateral = true
+
 	U(&joinedTable).SetRelation(relation)
 
 	return &joinedTable
@@ -77,22 +172,166 @@ type Relation struct {
 	baseTable    Table
 	refTable     Table
 	onExpr       genorm.Expr
+	usingColumns []string
+	lateral      bool
 }
 
-func newRelation(relationType RelationType, baseTable, refTable Table, expr genorm.Expr) (*Relation, error) {
+func newRelation(relationType RelationType, baseTable, refTable Table, expr genorm.Expr, usingColumns []string) (*Relation, error) {
 	if err := relationType.validate(); err != nil {
 		return nil, fmt.Errorf("validate relation type: %w", err)
 	}
 
+	switch {
+	case relationType == crossJoin:
+		if expr != nil || len(usingColumns) != 0 {
+			return nil, errors.New("cross join with condition")
+		}
+	case len(usingColumns) != 0:
+		if expr != nil {
+			return nil, errors.New("both on and using condition")
+		}
+
+		for _, table := range []Table{baseTable, refTable} {
+			columnNames := map[string]struct{}{}
+			for _, column := range table.Columns() {
+				columnNames[column.ColumnName()] = struct{}{}
+			}
+
+			for _, columnName := range usingColumns {
+				if _, ok := columnNames[columnName]; !ok {
+					return nil, fmt.Errorf("using column %s is not in the joined tables", columnName)
+				}
+			}
+		}
+	case expr == nil:
+		return nil, errors.New("empty join condition(use CrossJoin for CROSS JOIN)")
+	}
+
 	return &Relation{
 		relationType: relationType,
 		baseTable:    baseTable,
 		refTable:     refTable,
 		onExpr:       expr,
+		usingColumns: usingColumns,
 	}, nil
 }
 
+// ValidateDialect check that the relation and the joined tables are supported in the dialect
+func (r *Relation) ValidateDialect(dialect genorm.Dialect) error {
+	if r == nil {
+		return nil
+	}
+
+	if r.relationType == fullJoin && dialect != genorm.PostgreSQL {
+		return errors.New("full outer join is supported only in PostgreSQL")
+	}
+
+	for _, table := range []Table{r.baseTable, r.refTable} {
+		dv, ok := table.(genorm.DialectValidator)
+		if !ok {
+			continue
+		}
+
+		err := dv.ValidateDialect(dialect)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/*
+Detach
+the table joined with target and the join condition(nil for CROSS JOIN).
+target must be the base table or the ref table of the INNER JOIN or CROSS JOIN.
+*/
+func (r *Relation) Detach(target genorm.BasicTable) (genorm.Table, genorm.Expr, error) {
+	if r == nil {
+		return nil, nil, errors.New("nil relation")
+	}
+	if target == nil {
+		return nil, nil, errors.New("nil target")
+	}
+
+	switch {
+	case r.relationType != join && r.relationType != crossJoin:
+		return nil, nil, errors.New("detach from outer join")
+	case len(r.usingColumns) != 0:
+		return nil, nil, errors.New("detach from join using")
+	case r.lateral:
+		return nil, nil, errors.New("detach from lateral join")
+	}
+
+	isTarget := func(table Table) bool {
+		basicTable, ok := table.(genorm.BasicTable)
+		return ok && basicTable.TableName() == target.TableName()
+	}
+
+	switch {
+	case isTarget(r.refTable):
+		return r.baseTable, r.onExpr, nil
+	case isTarget(r.baseTable):
+		return r.refTable, r.onExpr, nil
+	}
+
+	return nil, nil, fmt.Errorf("%s is not joined at the top level", target.TableName())
+}
+
+// NullableTables tables which can be NULL by outer joins
+func (r *Relation) NullableTables() []genorm.BasicTable {
+	if r == nil {
+		return nil
+	}
+
+	var baseNullableTables, refNullableTables []genorm.BasicTable
+	switch r.relationType {
+	case leftJoin:
+		baseNullableTables = nullableTables(r.baseTable)
+		refNullableTables = baseTables(r.refTable)
+	case rightJoin:
+		baseNullableTables = baseTables(r.baseTable)
+		refNullableTables = nullableTables(r.refTable)
+	case fullJoin:
+		baseNullableTables = baseTables(r.baseTable)
+		refNullableTables = baseTables(r.refTable)
+	default:
+		baseNullableTables = nullableTables(r.baseTable)
+		refNullableTables = nullableTables(r.refTable)
+	}
+
+	return append(baseNullableTables, refNullableTables...)
+}
+
+func baseTables(table Table) []genorm.BasicTable {
+	switch t := table.(type) {
+	case genorm.JoinedTable:
+		return t.BaseTables()
+	case genorm.BasicTable:
+		return []genorm.BasicTable{t}
+	}
+
+	return nil
+}
+
+func nullableTables(table Table) []genorm.BasicTable {
+	if ojt, ok := table.(genorm.OuterJoinedTable); ok {
+		return ojt.NullableTables()
+	}
+
+	return nil
+}
+
 func (r *Relation) JoinedTableName() (string, []genorm.ExprType, []error) {
+	return r.IndexHintExpr(nil)
+}
+
+// IndexHintExpr JoinedTableName with the index hints right after the references of the hinted tables
+func (r *Relation) IndexHintExpr(hints *genorm.IndexHints) (string, []genorm.ExprType, []error) {
+	if r == nil {
+		return "", nil, []error{errors.New("nil relation")}
+	}
+
 	sb := strings.Builder{}
 	args := []genorm.ExprType{}
 
@@ -102,7 +341,7 @@ func (r *Relation) JoinedTableName() (string, []genorm.ExprType, []error) {
 		return "", nil, []error{fmt.Errorf("write string(%s): %w", str, err)}
 	}
 
-	baseTableQuery, baseTableArgs, errs := r.baseTable.Expr()
+	baseTableQuery, baseTableArgs, errs := hints.TableExpr(r.baseTable)
 	if len(errs) != 0 {
 		return "", nil, errs
 	}
@@ -116,36 +355,38 @@ func (r *Relation) JoinedTableName() (string, []genorm.ExprType, []error) {
 
 	switch r.relationType {
 	case join:
-		if r.onExpr != nil {
-			str = " INNER JOIN "
-			_, err = sb.WriteString(str)
-			if err != nil {
-				return "", nil, []error{fmt.Errorf("write string(%s): %w", str, err)}
-			}
-		} else {
-			str = " CROSS JOIN "
-			_, err = sb.WriteString(str)
-			if err != nil {
-				return "", nil, []error{fmt.Errorf("write string(%s): %w", str, err)}
-			}
-		}
+		str = " INNER JOIN "
 	case leftJoin:
 		str = " LEFT JOIN "
-		_, err = sb.WriteString(str)
-		if err != nil {
-			return "", nil, []error{fmt.Errorf("write string(%s): %w", str, err)}
-		}
 	case rightJoin:
 		str = " RIGHT JOIN "
-		_, err = sb.WriteString(str)
-		if err != nil {
-			return "", nil, []error{fmt.Errorf("write string(%s): %w", str, err)}
-		}
+	case fullJoin:
+		str = " FULL OUTER JOIN "
+	case crossJoin:
+		str = " CROSS JOIN "
 	default:
 		return "", nil, []error{errors.New("unsupported relation type")}
 	}
 
-	refTableQuery, refTableArgs, errs := r.refTable.Expr()
+	_, err = sb.WriteString(str)
+	if err != nil {
+		return "", nil, []error{fmt.Errorf("write string(%s): %w", str, err)}
+	}
+
+	var (
+		refTableQuery string
+		refTableArgs  []genorm.ExprType
+	)
+	if r.lateral {
+		lateralTable, ok := r.refTable.(genorm.LateralTable)
+		if !ok {
+			return "", nil, []error{errors.New("lateral join with a table which is not a subquery")}
+		}
+
+		refTableQuery, refTableArgs, errs = lateralTable.LateralExpr(r.baseTable)
+	} else {
+		refTableQuery, refTableArgs, errs = hints.TableExpr(r.refTable)
+	}
 	if len(errs) != 0 {
 		return "", nil, errs
 	}
@@ -157,7 +398,15 @@ func (r *Relation) JoinedTableName() (string, []genorm.ExprType, []error) {
 
 	args = append(args, refTableArgs...)
 
-	if r.onExpr != nil {
+	switch {
+	case r.relationType == crossJoin:
+	case len(r.usingColumns) != 0:
+		str = fmt.Sprintf(" USING (%s)", strings.Join(r.usingColumns, ", "))
+		_, err = sb.WriteString(str)
+		if err != nil {
+			return "", nil, []error{fmt.Errorf("write string(%s): %w", str, err)}
+		}
+	case r.onExpr != nil:
 		str = " ON "
 		_, err = sb.WriteString(str)
 		if err != nil {
@@ -175,6 +424,8 @@ func (r *Relation) JoinedTableName() (string, []genorm.ExprType, []error) {
 		}
 
 		args = append(args, onExprArgs...)
+	default:
+		return "", nil, []error{errors.New("empty join condition")}
 	}
 
 	str = ")"
@@ -193,12 +444,15 @@ const (
 	join RelationType = iota + 1
 	leftJoin
 	rightJoin
+	fullJoin
+	crossJoin
 )
 
 func (rt RelationType) validate() error {
-	if rt != join && rt != leftJoin && rt != rightJoin {
-		return errors.New("unsupported relation type")
+	switch rt {
+	case join, leftJoin, rightJoin, fullJoin, crossJoin:
+		return nil
 	}
 
-	return nil
+	return errors.New("unsupported relation type")
 }
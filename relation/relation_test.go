@@ -25,16 +25,58 @@ func TestJoinedTableName(t *testing.T) {
 		baseExpr     expr
 		refTableExpr expr
 		onExpr       *expr
+		usingColumns []string
+		lateral      bool
+		notSubquery  bool
 		query        string
 		args         []genorm.ExprType
 		err          bool
 	}{
 		{
-			description:  "normal",
+			description:  "lateral join",
 			relationType: join,
 			baseExpr: expr{
 				query: "hoge",
 			},
+			refTableExpr: expr{
+				query: "(SELECT fuga.id AS value0 FROM fuga WHERE (fuga.hoge_id = hoge.id)) AS fuga",
+			},
+			onExpr: &expr{
+				query: "(hoge.id = fuga.value0)",
+			},
+			lateral: true,
+			query:   "(hoge INNER JOIN LATERAL (SELECT fuga.id AS value0 FROM fuga WHERE (fuga.hoge_id = hoge.id)) AS fuga ON (hoge.id = fuga.value0))",
+			args:    []genorm.ExprType{},
+		},
+		{
+			description:  "cross lateral join",
+			relationType: crossJoin,
+			baseExpr: expr{
+				query: "hoge",
+			},
+			refTableExpr: expr{
+				query: "(SELECT fuga.id AS value0 FROM fuga WHERE (fuga.hoge_id = hoge.id)) AS fuga",
+			},
+			lateral: true,
+			query:   "(hoge CROSS JOIN LATERAL (SELECT fuga.id AS value0 FROM fuga WHERE (fuga.hoge_id = hoge.id)) AS fuga)",
+			args:    []genorm.ExprType{},
+		},
+		{
+			description:  "lateral join with not subquery",
+			relationType: crossJoin,
+			baseExpr: expr{
+				query: "hoge",
+			},
+			lateral:     true,
+			notSubquery: true,
+			err:         true,
+		},
+		{
+			description:  "cross join",
+			relationType: crossJoin,
+			baseExpr: expr{
+				query: "hoge",
+			},
 			refTableExpr: expr{
 				query: "fuga",
 			},
@@ -88,7 +130,7 @@ func TestJoinedTableName(t *testing.T) {
 		},
 		{
 			description:  "baseTable with args",
-			relationType: join,
+			relationType: crossJoin,
 			baseExpr: expr{
 				query: "(hoge INNER JOIN fuga ON (fuga.id = ?))",
 				args:  []genorm.ExprType{genorm.Wrap(1)},
@@ -112,7 +154,7 @@ func TestJoinedTableName(t *testing.T) {
 		},
 		{
 			description:  "refTable with args",
-			relationType: join,
+			relationType: crossJoin,
 			baseExpr: expr{
 				query: "hoge",
 			},
@@ -164,6 +206,45 @@ func TestJoinedTableName(t *testing.T) {
 			query: "(hoge RIGHT JOIN fuga ON (hoge.id = fuga.id))",
 			args:  []genorm.ExprType{},
 		},
+		{
+			description:  "full join",
+			relationType: fullJoin,
+			baseExpr: expr{
+				query: "hoge",
+			},
+			refTableExpr: expr{
+				query: "fuga",
+			},
+			onExpr: &expr{
+				query: "(hoge.id = fuga.id)",
+			},
+			query: "(hoge FULL OUTER JOIN fuga ON (hoge.id = fuga.id))",
+			args:  []genorm.ExprType{},
+		},
+		{
+			description:  "join using",
+			relationType: join,
+			baseExpr: expr{
+				query: "hoge",
+			},
+			refTableExpr: expr{
+				query: "fuga",
+			},
+			usingColumns: []string{"id", "name"},
+			query:        "(hoge INNER JOIN fuga USING (id, name))",
+			args:         []genorm.ExprType{},
+		},
+		{
+			description:  "join without condition",
+			relationType: join,
+			baseExpr: expr{
+				query: "hoge",
+			},
+			refTableExpr: expr{
+				query: "fuga",
+			},
+			err: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -176,14 +257,21 @@ func TestJoinedTableName(t *testing.T) {
 				Expr().
 				Return(test.baseExpr.query, test.baseExpr.args, test.baseExpr.errs)
 
-			refTable := mock.NewMockTable(ctrl)
-			if len(test.baseExpr.errs) == 0 {
-				refTable.
+			mockRefTable := mock.NewMockTable(ctrl)
+			if len(test.baseExpr.errs) == 0 && !test.notSubquery {
+				mockRefTable.
 					EXPECT().
 					Expr().
 					Return(test.refTableExpr.query, test.refTableExpr.args, test.refTableExpr.errs)
 			}
 
+			var refTable Table = mockRefTable
+			if test.lateral && !test.notSubquery {
+				refTable = lateralTable{
+					Table: mockRefTable,
+				}
+			}
+
 			var onExpr genorm.Expr
 			if test.onExpr != nil {
 				mockExpr := mock.NewMockExpr(ctrl)
@@ -200,6 +288,8 @@ func TestJoinedTableName(t *testing.T) {
 				baseTable:    baseTable,
 				refTable:     refTable,
 				onExpr:       onExpr,
+				usingColumns: test.usingColumns,
+				lateral:      test.lateral,
 			}
 
 			query, args, errs := relation.JoinedTableName()
@@ -216,3 +306,468 @@ func TestJoinedTableName(t *testing.T) {
 		})
 	}
 }
+
+type lateralTable struct {
+	genorm.Table
+}
+
+func (t lateralTable) LateralExpr(genorm.Table) (string, []genorm.ExprType, []error) {
+	query, args, errs := t.Expr()
+	if len(errs) != 0 {
+		return "", nil, errs
+	}
+
+	return "LATERAL " + query, args, nil
+}
+
+func TestNewRelation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description     string
+		relationType    RelationType
+		onExpr          bool
+		usingColumns    []string
+		baseColumnNames []string
+		refColumnNames  []string
+		err             bool
+	}{
+		{
+			description:  "join",
+			relationType: join,
+			onExpr:       true,
+		},
+		{
+			description:  "join without condition",
+			relationType: join,
+			err:          true,
+		},
+		{
+			description:  "left join without condition",
+			relationType: leftJoin,
+			err:          true,
+		},
+		{
+			description:  "cross join",
+			relationType: crossJoin,
+		},
+		{
+			description:  "cross join with on",
+			relationType: crossJoin,
+			onExpr:       true,
+			err:          true,
+		},
+		{
+			description:     "join using",
+			relationType:    join,
+			usingColumns:    []string{"id"},
+			baseColumnNames: []string{"id", "name"},
+			refColumnNames:  []string{"id", "content"},
+		},
+		{
+			description:     "using column not in ref table",
+			relationType:    join,
+			usingColumns:    []string{"name"},
+			baseColumnNames: []string{"id", "name"},
+			refColumnNames:  []string{"id", "content"},
+			err:             true,
+		},
+		{
+			description:     "both on and using",
+			relationType:    join,
+			onExpr:          true,
+			usingColumns:    []string{"id"},
+			baseColumnNames: []string{"id"},
+			refColumnNames:  []string{"id"},
+			err:             true,
+		},
+		{
+			description:  "invalid relation type",
+			relationType: 0,
+			onExpr:       true,
+			err:          true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			tableWithColumns := func(columnNames []string) Table {
+				table := mock.NewMockTable(ctrl)
+
+				columns := make([]genorm.Column, 0, len(columnNames))
+				for _, columnName := range columnNames {
+					column := mock.NewMockColumn(ctrl)
+					column.
+						EXPECT().
+						ColumnName().
+						Return(columnName).
+						AnyTimes()
+
+					columns = append(columns, column)
+				}
+
+				table.
+					EXPECT().
+					Columns().
+					Return(columns).
+					AnyTimes()
+
+				return table
+			}
+
+			var onExpr genorm.Expr
+			if test.onExpr {
+				onExpr = mock.NewMockExpr(ctrl)
+			}
+
+			relation, err := newRelation(
+				test.relationType,
+				tableWithColumns(test.baseColumnNames),
+				tableWithColumns(test.refColumnNames),
+				onExpr,
+				test.usingColumns,
+			)
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.relationType, relation.relationType)
+			assert.Equal(t, test.usingColumns, relation.usingColumns)
+		})
+	}
+}
+
+func TestRelationValidateDialect(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description  string
+		relationType RelationType
+		baseTable    *Relation
+		dialect      genorm.Dialect
+		err          bool
+	}{
+		{
+			description:  "join mysql",
+			relationType: join,
+			dialect:      genorm.MySQL,
+		},
+		{
+			description:  "full join postgres",
+			relationType: fullJoin,
+			dialect:      genorm.PostgreSQL,
+		},
+		{
+			description:  "full join mysql",
+			relationType: fullJoin,
+			dialect:      genorm.MySQL,
+			err:          true,
+		},
+		{
+			description:  "nested full join mysql",
+			relationType: join,
+			baseTable: &Relation{
+				relationType: fullJoin,
+			},
+			dialect: genorm.MySQL,
+			err:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var baseTable Table = mock.NewMockTable(ctrl)
+			if test.baseTable != nil {
+				baseTable = validateDialectTable{
+					Table:    mock.NewMockTable(ctrl),
+					relation: test.baseTable,
+				}
+			}
+
+			relation := &Relation{
+				relationType: test.relationType,
+				baseTable:    baseTable,
+				refTable:     mock.NewMockTable(ctrl),
+			}
+
+			err := relation.ValidateDialect(test.dialect)
+			if test.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+type validateDialectTable struct {
+	genorm.Table
+	relation *Relation
+}
+
+func (t validateDialectTable) ValidateDialect(dialect genorm.Dialect) error {
+	return t.relation.ValidateDialect(dialect)
+}
+
+func TestRelationNullableTables(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description    string
+		relationType   RelationType
+		nested         *Relation
+		nullableTables []string
+	}{
+		{
+			description:  "join",
+			relationType: join,
+		},
+		{
+			description:    "left join",
+			relationType:   leftJoin,
+			nullableTables: []string{"fuga"},
+		},
+		{
+			description:    "right join",
+			relationType:   rightJoin,
+			nullableTables: []string{"hoge"},
+		},
+		{
+			description:    "full join",
+			relationType:   fullJoin,
+			nullableTables: []string{"hoge", "fuga"},
+		},
+		{
+			description:  "join with nested left join",
+			relationType: join,
+			nested: &Relation{
+				relationType: leftJoin,
+			},
+			nullableTables: []string{"piyo"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			basicTable := func(tableName string) genorm.BasicTable {
+				table := mock.NewMockBasicTable(ctrl)
+				table.
+					EXPECT().
+					TableName().
+					Return(tableName).
+					AnyTimes()
+
+				return table
+			}
+
+			var baseTable Table = basicTable("hoge")
+			if test.nested != nil {
+				test.nested.baseTable = basicTable("hoge")
+				test.nested.refTable = basicTable("piyo")
+
+				baseTable = nullableTablesTable{
+					BasicTable: basicTable("hoge"),
+					relation:   test.nested,
+				}
+			}
+
+			relation := &Relation{
+				relationType: test.relationType,
+				baseTable:    baseTable,
+				refTable:     basicTable("fuga"),
+			}
+
+			tableNames := []string{}
+			for _, table := range relation.NullableTables() {
+				tableNames = append(tableNames, table.TableName())
+			}
+
+			if len(test.nullableTables) == 0 {
+				assert.Empty(t, tableNames)
+				return
+			}
+
+			assert.Equal(t, test.nullableTables, tableNames)
+		})
+	}
+}
+
+type nullableTablesTable struct {
+	genorm.BasicTable
+	relation *Relation
+}
+
+func (t nullableTablesTable) NullableTables() []genorm.BasicTable {
+	return t.relation.NullableTables()
+}
+
+func TestRelationDetach(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description  string
+		relationType RelationType
+		usingColumns []string
+		lateral      bool
+		targetName   string
+		restName     string
+		isError      bool
+	}{
+		{
+			description:  "ref table",
+			relationType: join,
+			targetName:   "fuga",
+			restName:     "hoge",
+		},
+		{
+			description:  "base table",
+			relationType: join,
+			targetName:   "hoge",
+			restName:     "fuga",
+		},
+		{
+			description:  "cross join",
+			relationType: crossJoin,
+			targetName:   "fuga",
+			restName:     "hoge",
+		},
+		{
+			description:  "left join",
+			relationType: leftJoin,
+			targetName:   "fuga",
+			isError:      true,
+		},
+		{
+			description:  "join using",
+			relationType: join,
+			usingColumns: []string{"id"},
+			targetName:   "fuga",
+			isError:      true,
+		},
+		{
+			description:  "lateral join",
+			relationType: join,
+			lateral:      true,
+			targetName:   "fuga",
+			isError:      true,
+		},
+		{
+			description:  "not joined",
+			relationType: join,
+			targetName:   "piyo",
+			isError:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			baseTable := mock.NewMockBasicTable(ctrl)
+			baseTable.EXPECT().TableName().Return("hoge").AnyTimes()
+			refTable := mock.NewMockBasicTable(ctrl)
+			refTable.EXPECT().TableName().Return("fuga").AnyTimes()
+			target := mock.NewMockBasicTable(ctrl)
+			target.EXPECT().TableName().Return(test.targetName).AnyTimes()
+
+			var onExpr genorm.Expr
+			if test.relationType != crossJoin && len(test.usingColumns) == 0 {
+				onExpr = mock.NewMockExpr(ctrl)
+			}
+
+			relation := &Relation{
+				relationType: test.relationType,
+				baseTable:    baseTable,
+				refTable:     refTable,
+				onExpr:       onExpr,
+				usingColumns: test.usingColumns,
+				lateral:      test.lateral,
+			}
+
+			restTable, restOnExpr, err := relation.Detach(target)
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			if basicTable, ok := restTable.(genorm.BasicTable); assert.True(t, ok) {
+				assert.Equal(t, test.restName, basicTable.TableName())
+			}
+			assert.Equal(t, onExpr, restOnExpr)
+		})
+	}
+}
+
+func TestFKRelationContextJoinOnFK(t *testing.T) {
+	t.Parallel()
+
+	type pairTable = PairTable[mock.MockBasicTable, *mock.MockBasicTable, mock.MockBasicTable, *mock.MockBasicTable]
+
+	tests := []struct {
+		description string
+		fkExpr      bool
+		err         bool
+	}{
+		{
+			description: "foreign key",
+			fkExpr:      true,
+		},
+		{
+			description: "nil foreign key",
+			err:         true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var fkExpr genorm.TypedTableExpr[*pairTable, genorm.WrappedPrimitive[bool]]
+			if test.fkExpr {
+				fkExpr = mock.NewMockTypedTableExpr[*pairTable, genorm.WrappedPrimitive[bool]](ctrl)
+			}
+
+			relationContext := NewRelationContext[*mock.MockBasicTable, *mock.MockBasicTable, *pairTable, pairTable](
+				mock.NewMockBasicTable(ctrl),
+				mock.NewMockBasicTable(ctrl),
+			)
+
+			joinedTable := relationContext.WithForeignKey(fkExpr).JoinOnFK()
+			if test.err {
+				assert.NotEmpty(t, joinedTable.GetErrors())
+				return
+			}
+
+			if !assert.Empty(t, joinedTable.GetErrors()) {
+				return
+			}
+
+			assert.Equal(t, join, joinedTable.relation.relationType)
+			assert.Equal(t, fkExpr, joinedTable.relation.onExpr)
+
+			// the relation context without the foreign key is not changed
+			joinedTable = relationContext.CrossJoin()
+			if !assert.Empty(t, joinedTable.GetErrors()) {
+				return
+			}
+
+			assert.Equal(t, crossJoin, joinedTable.relation.relationType)
+			assert.Nil(t, joinedTable.relation.onExpr)
+		})
+	}
+}
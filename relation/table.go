@@ -17,6 +17,10 @@ type JoinedTable interface {
 	Table
 	genorm.JoinedTable
 	SetRelation(*Relation)
+	ValidateDialect(genorm.Dialect) error
+	NullableTables() []genorm.BasicTable
+	Detach(genorm.BasicTable) (genorm.Table, genorm.Expr, error)
+	IndexHintExpr(*genorm.IndexHints) (string, []genorm.ExprType, []error)
 }
 
 type JoinedTablePointer[T any] interface {
@@ -0,0 +1,348 @@
+package genorm
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ScanField mapping from an expression to a field of the destination struct
+type ScanField[T Table] struct {
+	name          string
+	expr          TableExpr[T]
+	exprType      reflect.Type
+	primitiveType reflect.Type
+}
+
+/*
+Field map expr to the field of the destination struct.
+name is the value of `genorm` struct tag, or the field name if the tag is not set.
+*/
+func Field[T Table, S ExprType](name string, expr TypedTableExpr[T, S]) ScanField[T] {
+	field := ScanField[T]{
+		name:     name,
+		expr:     expr,
+		exprType: reflect.TypeFor[S](),
+	}
+
+	var s S
+	if wp, ok := any(s).(interface{ primitiveType() reflect.Type }); ok {
+		field.primitiveType = wp.primitiveType()
+	}
+
+	return field
+}
+
+/*
+assignableTo
+S: field of type S
+WrappedPrimitive[P]: field of type P or *P
+*/
+func (f *ScanField[_]) assignableTo(fieldType reflect.Type) bool {
+	if fieldType == f.exprType {
+		return true
+	}
+
+	if f.primitiveType == nil {
+		return false
+	}
+
+	return fieldType == f.primitiveType || fieldType == reflect.PointerTo(f.primitiveType)
+}
+
+type ScanContext[S any, T TablePointer[S], D any] struct {
+	selectContext *SelectContext[S, T]
+	fields        []ScanField[T]
+	fieldIndexes  [][]int
+}
+
+// Scan select fields into the struct D. The fields are checked against D once here.
+func Scan[D any, S any, T TablePointer[S]](table T, fields ...ScanField[T]) *ScanContext[S, T, D] {
+	ctx := &ScanContext[S, T, D]{
+		selectContext: Select(table),
+		fields:        fields,
+	}
+
+	fieldIndexes, err := scanFieldIndexes[D](fields)
+	if err != nil {
+		ctx.selectContext.addError(fmt.Errorf("scan fields: %w", err))
+	}
+	ctx.fieldIndexes = fieldIndexes
+
+	return ctx
+}
+
+func scanFieldIndexes[D any, T Table](fields []ScanField[T]) ([][]int, error) {
+	destType := reflect.TypeFor[D]()
+	if destType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("destination must be struct: %s", destType)
+	}
+
+	if len(fields) == 0 {
+		return nil, errors.New("no fields")
+	}
+
+	structFields := map[string]reflect.StructField{}
+	for _, structField := range reflect.VisibleFields(destType) {
+		if structField.Anonymous || !structField.IsExported() {
+			continue
+		}
+
+		name, ok := structField.Tag.Lookup("genorm")
+		if name == "-" {
+			continue
+		}
+		if !ok || len(name) == 0 {
+			name = structField.Name
+		}
+
+		if _, ok := structFields[name]; ok {
+			return nil, fmt.Errorf("duplicate field name: %s", name)
+		}
+
+		structFields[name] = structField
+	}
+
+	fieldIndexes := make([][]int, 0, len(fields))
+	usedFields := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		if field.expr == nil {
+			return nil, fmt.Errorf("nil expr for field %s", field.name)
+		}
+
+		if _, ok := usedFields[field.name]; ok {
+			return nil, fmt.Errorf("field %s mapped twice", field.name)
+		}
+		usedFields[field.name] = struct{}{}
+
+		structField, ok := structFields[field.name]
+		if !ok {
+			return nil, fmt.Errorf("field %s not found in %s", field.name, destType)
+		}
+
+		if !field.assignableTo(structField.Type) {
+			return nil, fmt.Errorf("cannot scan %s into field %s(%s)", field.exprType, field.name, structField.Type)
+		}
+
+		err := validateEmbeddedPointers(destType, structField.Index)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.name, err)
+		}
+
+		fieldIndexes = append(fieldIndexes, structField.Index)
+	}
+
+	return fieldIndexes, nil
+}
+
+// validateEmbeddedPointers embedded struct pointers on the way to the field must be exported to be allocated
+func validateEmbeddedPointers(destType reflect.Type, index []int) error {
+	structType := destType
+	for _, i := range index[:len(index)-1] {
+		embeddedField := structType.Field(i)
+
+		structType = embeddedField.Type
+		if structType.Kind() != reflect.Pointer {
+			continue
+		}
+
+		if !embeddedField.IsExported() {
+			return fmt.Errorf("cannot allocate unexported embedded pointer %s", embeddedField.Name)
+		}
+
+		structType = structType.Elem()
+	}
+
+	return nil
+}
+
+// withSelectContext the context with the clauses of selectContext
+func (c *ScanContext[S, T, D]) withSelectContext(selectContext *SelectContext[S, T]) *ScanContext[S, T, D] {
+	clone := *c
+	clone.selectContext = selectContext
+
+	return &clone
+}
+
+func (c *ScanContext[S, T, D]) Errors() []error {
+	return c.selectContext.Errors()
+}
+
+func (c *ScanContext[S, T, D]) Distinct() *ScanContext[S, T, D] {
+	return c.withSelectContext(c.selectContext.Distinct())
+}
+
+func (c *ScanContext[S, T, D]) Where(
+	condition TypedTableExpr[T, WrappedPrimitive[bool]],
+) *ScanContext[S, T, D] {
+	return c.withSelectContext(c.selectContext.Where(condition))
+}
+
+func (c *ScanContext[S, T, D]) OrWhere(
+	condition TypedTableExpr[T, WrappedPrimitive[bool]],
+) *ScanContext[S, T, D] {
+	return c.withSelectContext(c.selectContext.OrWhere(condition))
+}
+
+func (c *ScanContext[S, T, D]) GroupBy(exprs ...TableExpr[T]) *ScanContext[S, T, D] {
+	return c.withSelectContext(c.selectContext.GroupBy(exprs...))
+}
+
+func (c *ScanContext[S, T, D]) Having(
+	condition TypedTableExpr[T, WrappedPrimitive[bool]],
+) *ScanContext[S, T, D] {
+	return c.withSelectContext(c.selectContext.Having(condition))
+}
+
+func (c *ScanContext[S, T, D]) OrderBy(direction OrderDirection, expr TableExpr[T]) *ScanContext[S, T, D] {
+	return c.withSelectContext(c.selectContext.OrderBy(direction, expr))
+}
+
+func (c *ScanContext[S, T, D]) Limit(limit uint64) *ScanContext[S, T, D] {
+	return c.withSelectContext(c.selectContext.Limit(limit))
+}
+
+func (c *ScanContext[S, T, D]) Offset(offset uint64) *ScanContext[S, T, D] {
+	return c.withSelectContext(c.selectContext.Offset(offset))
+}
+
+func (c *ScanContext[S, T, D]) Lock(lockType LockType, options ...LockOption) *ScanContext[S, T, D] {
+	return c.withSelectContext(c.selectContext.Lock(lockType, options...))
+}
+
+// IndexHint index hint for table in the FROM clause(MySQL only)
+func (c *ScanContext[S, T, D]) IndexHint(table BasicTable, hintType IndexHintType, indexes ...string) *ScanContext[S, T, D] {
+	return c.withSelectContext(c.selectContext.IndexHint(table, hintType, indexes...))
+}
+
+// OptimizerHint optimizer hint comment(/*+ hint */) after SELECT(MySQL only)
+func (c *ScanContext[S, T, D]) OptimizerHint(hint string) *ScanContext[S, T, D] {
+	return c.withSelectContext(c.selectContext.OptimizerHint(hint))
+}
+
+func (c *ScanContext[S, T, D]) Dialect(dialect Dialect) *ScanContext[S, T, D] {
+	return c.withSelectContext(c.selectContext.Dialect(dialect))
+}
+
+func (c *ScanContext[S, T, D]) GetAllCtx(ctx context.Context, db DB) ([]D, error) {
+	query, exprArgs, err := c.buildQuery()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	args := make([]any, 0, len(exprArgs))
+	for _, arg := range exprArgs {
+		args = append(args, arg)
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return []D{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	dests := []D{}
+	for rows.Next() {
+		var dest D
+
+		err = rows.Scan(c.fieldDests(&dest)...)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		dests = append(dests, dest)
+	}
+
+	return dests, nil
+}
+
+func (c *ScanContext[S, T, D]) GetAll(db DB) ([]D, error) {
+	return c.GetAllCtx(context.Background(), db)
+}
+
+func (c *ScanContext[S, T, D]) GetCtx(ctx context.Context, db DB) (D, error) {
+	var dest D
+
+	query, queryArgs, err := c.Limit(1).buildQuery()
+	if err != nil {
+		return dest, fmt.Errorf("build query: %w", err)
+	}
+
+	args := make([]any, 0, len(queryArgs))
+	for _, arg := range queryArgs {
+		args = append(args, arg)
+	}
+
+	row := db.QueryRowContext(ctx, query, args...)
+
+	err = row.Scan(c.fieldDests(&dest)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return dest, ErrRecordNotFound
+	}
+	if err != nil {
+		return dest, fmt.Errorf("query: %w", err)
+	}
+
+	return dest, nil
+}
+
+func (c *ScanContext[S, T, D]) Get(db DB) (D, error) {
+	return c.GetCtx(context.Background(), db)
+}
+
+// fieldDests pointers to the fields of dest in the order of fields
+func (c *ScanContext[S, T, D]) fieldDests(dest *D) []any {
+	destValue := reflect.ValueOf(dest).Elem()
+
+	fieldDests := make([]any, 0, len(c.fieldIndexes))
+	for _, index := range c.fieldIndexes {
+		fieldDests = append(fieldDests, fieldByIndex(destValue, index).Addr().Interface())
+	}
+
+	return fieldDests
+}
+
+// fieldByIndex field of index, allocating the nil embedded struct pointers on the way
+func fieldByIndex(value reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i != 0 && value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				value.Set(reflect.New(value.Type().Elem()))
+			}
+
+			value = value.Elem()
+		}
+
+		value = value.Field(x)
+	}
+
+	return value
+}
+
+// buildQuery SELECT expr AS res0, ... with the clauses of the select context
+func (c *ScanContext[S, T, D]) buildQuery() (string, []ExprType, error) {
+	errs := c.Errors()
+	if len(errs) != 0 {
+		return "", nil, errs[0]
+	}
+
+	selectExprs := make([]string, 0, len(c.fields))
+	args := []ExprType{}
+	for i, field := range c.fields {
+		fieldQuery, fieldArgs, errs := field.expr.Expr()
+		if len(errs) != 0 {
+			return "", nil, fmt.Errorf("field(%s): %w", field.name, errs[0])
+		}
+
+		selectExprs = append(selectExprs, fmt.Sprintf("%s AS res%d", fieldQuery, i))
+		args = append(args, fieldArgs...)
+	}
+
+	return c.selectContext.buildSelectQuery(strings.Join(selectExprs, ", "), args)
+}
@@ -0,0 +1,9 @@
+package genorm
+
+func (c *ScanContext[_, _, _]) BuildQuery() (string, []ExprType, error) {
+	return c.buildQuery()
+}
+
+func (c *ScanContext[_, _, D]) FieldDests(dest *D) []any {
+	return c.fieldDests(dest)
+}
@@ -0,0 +1,419 @@
+package genorm_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mazrean/genorm"
+	"github.com/mazrean/genorm/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type scanTestEmbedded struct {
+	Count int64 `genorm:"count"`
+}
+
+type scanTestDest struct {
+	scanTestEmbedded
+	ID       genorm.WrappedPrimitive[int64]
+	Name     string  `genorm:"name"`
+	Nickname *string `genorm:"nickname"`
+	Ignored  string  `genorm:"-"`
+	private  string
+}
+
+func TestScanFields(t *testing.T) {
+	t.Parallel()
+
+	type field struct {
+		name      string
+		exprType  string
+		exprIsNil bool
+	}
+
+	tests := []struct {
+		description string
+		fields      []field
+		isError     bool
+	}{
+		{
+			description: "wrapped primitive field",
+			fields:      []field{{name: "ID", exprType: "int64"}},
+		},
+		{
+			description: "primitive field",
+			fields:      []field{{name: "name", exprType: "string"}},
+		},
+		{
+			description: "pointer field",
+			fields:      []field{{name: "nickname", exprType: "string"}},
+		},
+		{
+			description: "embedded field",
+			fields:      []field{{name: "count", exprType: "int64"}},
+		},
+		{
+			description: "multiple fields",
+			fields: []field{
+				{name: "ID", exprType: "int64"},
+				{name: "name", exprType: "string"},
+				{name: "count", exprType: "int64"},
+			},
+		},
+		{
+			description: "no fields",
+			isError:     true,
+		},
+		{
+			description: "unknown field",
+			fields:      []field{{name: "unknown", exprType: "string"}},
+			isError:     true,
+		},
+		{
+			description: "field name hidden by tag",
+			fields:      []field{{name: "Name", exprType: "string"}},
+			isError:     true,
+		},
+		{
+			description: "ignored field",
+			fields:      []field{{name: "Ignored", exprType: "string"}},
+			isError:     true,
+		},
+		{
+			description: "unexported field",
+			fields:      []field{{name: "private", exprType: "string"}},
+			isError:     true,
+		},
+		{
+			description: "type mismatch",
+			fields:      []field{{name: "name", exprType: "int64"}},
+			isError:     true,
+		},
+		{
+			description: "mapped twice",
+			fields: []field{
+				{name: "name", exprType: "string"},
+				{name: "name", exprType: "string"},
+			},
+			isError: true,
+		},
+		{
+			description: "nil expr",
+			fields:      []field{{name: "name", exprType: "string", exprIsNil: true}},
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			table := mock.NewMockTable(ctrl)
+			table.
+				EXPECT().
+				GetErrors().
+				Return(nil)
+
+			fields := make([]genorm.ScanField[*mock.MockTable], 0, len(test.fields))
+			for _, field := range test.fields {
+				switch field.exprType {
+				case "int64":
+					var expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]
+					if !field.exprIsNil {
+						expr = mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]](ctrl)
+					}
+
+					fields = append(fields, genorm.Field(field.name, expr))
+				case "string":
+					var expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]]
+					if !field.exprIsNil {
+						expr = mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]](ctrl)
+					}
+
+					fields = append(fields, genorm.Field(field.name, expr))
+				}
+			}
+
+			builder := genorm.Scan[scanTestDest](table, fields...)
+			if !test.isError {
+				assert.Empty(t, builder.Errors())
+				return
+			}
+
+			assert.NotEmpty(t, builder.Errors())
+
+			// the field exprs are not rendered when the fields are invalid
+			_, _, err := builder.BuildQuery()
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestScanNotStruct(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	table := mock.NewMockTable(ctrl)
+	table.
+		EXPECT().
+		GetErrors().
+		Return(nil)
+
+	expr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]](ctrl)
+
+	errs := genorm.Scan[string](table, genorm.Field("name", expr)).Errors()
+	assert.NotEmpty(t, errs)
+}
+
+func TestScanBuildQuery(t *testing.T) {
+	t.Parallel()
+
+	type expr struct {
+		query string
+		args  []genorm.ExprType
+		errs  []error
+	}
+
+	tests := []struct {
+		description    string
+		tableExpr      expr
+		distinct       bool
+		idExpr         expr
+		nameExpr       expr
+		whereCondition *expr
+		limit          uint64
+		lockType       genorm.LockType
+		query          string
+		args           []genorm.ExprType
+		err            bool
+	}{
+		{
+			description: "normal",
+			tableExpr: expr{
+				query: "hoge",
+			},
+			idExpr: expr{
+				query: "hoge.id",
+			},
+			nameExpr: expr{
+				query: "hoge.name",
+			},
+			query: "SELECT hoge.id AS res0, hoge.name AS res1 FROM hoge",
+			args:  []genorm.ExprType{},
+		},
+		{
+			description: "joined table expr",
+			tableExpr: expr{
+				query: "hoge JOIN fuga ON hoge.id = fuga.id AND fuga.huga = ?",
+				args:  []genorm.ExprType{genorm.Wrap(2)},
+			},
+			idExpr: expr{
+				query: "(hoge.id + ?)",
+				args:  []genorm.ExprType{genorm.Wrap(1)},
+			},
+			nameExpr: expr{
+				query: "fuga.name",
+			},
+			query: "SELECT (hoge.id + ?) AS res0, fuga.name AS res1 FROM hoge JOIN fuga ON hoge.id = fuga.id AND fuga.huga = ?",
+			args:  []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(2)},
+		},
+		{
+			description: "distinct, where, limit and lock",
+			tableExpr: expr{
+				query: "hoge",
+			},
+			distinct: true,
+			idExpr: expr{
+				query: "hoge.id",
+			},
+			nameExpr: expr{
+				query: "hoge.name",
+			},
+			whereCondition: &expr{
+				query: "(hoge.name = ?)",
+				args:  []genorm.ExprType{genorm.Wrap("name")},
+			},
+			limit:    1,
+			lockType: genorm.ForUpdate,
+			query:    "SELECT DISTINCT hoge.id AS res0, hoge.name AS res1 FROM hoge WHERE (hoge.name = ?) LIMIT 1 FOR UPDATE",
+			args:     []genorm.ExprType{genorm.Wrap("name")},
+		},
+		{
+			description: "field error",
+			tableExpr: expr{
+				query: "hoge",
+			},
+			idExpr: expr{
+				errs: []error{errors.New("field error")},
+			},
+			nameExpr: expr{
+				query: "hoge.name",
+			},
+			err: true,
+		},
+		{
+			description: "table error",
+			tableExpr: expr{
+				errs: []error{errors.New("table error")},
+			},
+			idExpr: expr{
+				query: "hoge.id",
+			},
+			nameExpr: expr{
+				query: "hoge.name",
+			},
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			table := mock.NewMockTable(ctrl)
+			table.
+				EXPECT().
+				Expr().
+				Return(test.tableExpr.query, test.tableExpr.args, test.tableExpr.errs).
+				AnyTimes()
+			table.
+				EXPECT().
+				GetErrors().
+				Return(nil)
+
+			idExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]](ctrl)
+			idExpr.
+				EXPECT().
+				Expr().
+				Return(test.idExpr.query, test.idExpr.args, test.idExpr.errs)
+
+			nameExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]](ctrl)
+			nameExpr.
+				EXPECT().
+				Expr().
+				Return(test.nameExpr.query, test.nameExpr.args, test.nameExpr.errs).
+				AnyTimes()
+
+			builder := genorm.Scan[scanTestDest](
+				table,
+				genorm.Field("ID", idExpr),
+				genorm.Field("name", nameExpr),
+			)
+
+			if test.distinct {
+				builder = builder.Distinct()
+			}
+
+			if test.whereCondition != nil {
+				mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return(test.whereCondition.query, test.whereCondition.args, test.whereCondition.errs)
+
+				builder = builder.Where(mockExpr)
+			}
+
+			if test.limit != 0 {
+				builder = builder.Limit(test.limit)
+			}
+
+			if test.lockType != 0 {
+				builder = builder.Lock(test.lockType)
+			}
+
+			query, args, err := builder.BuildQuery()
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+			assert.Equal(t, test.args, args)
+		})
+	}
+}
+
+func TestScanFieldDests(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	table := mock.NewMockTable(ctrl)
+	table.
+		EXPECT().
+		GetErrors().
+		Return(nil)
+
+	idExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]](ctrl)
+	nameExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]](ctrl)
+	countExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]](ctrl)
+
+	builder := genorm.Scan[scanTestDest](
+		table,
+		genorm.Field("name", nameExpr),
+		genorm.Field("count", countExpr),
+		genorm.Field("ID", idExpr),
+	)
+
+	var dest scanTestDest
+	dests := builder.FieldDests(&dest)
+
+	expected := []any{&dest.Name, &dest.Count, &dest.ID}
+	if !assert.Len(t, dests, len(expected)) {
+		return
+	}
+
+	for i := range expected {
+		assert.Same(t, expected[i], dests[i])
+	}
+}
+
+type ScanTestPointerEmbedded struct {
+	Score int64 `genorm:"score"`
+}
+
+type scanTestPointerDest struct {
+	*ScanTestPointerEmbedded
+	*scanTestEmbedded
+}
+
+func TestScanEmbeddedPointer(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	table := mock.NewMockTable(ctrl)
+	table.
+		EXPECT().
+		GetErrors().
+		Return(nil).
+		AnyTimes()
+
+	builder := genorm.Scan[scanTestPointerDest](
+		table,
+		genorm.Field("score", mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]](ctrl)),
+	)
+	if !assert.Empty(t, builder.Errors()) {
+		return
+	}
+
+	var dest scanTestPointerDest
+	dests := builder.FieldDests(&dest)
+
+	if assert.NotNil(t, dest.ScanTestPointerEmbedded) && assert.Len(t, dests, 1) {
+		assert.Same(t, &dest.Score, dests[0])
+	}
+
+	errs := genorm.Scan[scanTestPointerDest](
+		table,
+		genorm.Field("count", mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]](ctrl)),
+	).Errors()
+	assert.NotEmpty(t, errs, "unexported embedded pointer")
+}
@@ -3,6 +3,7 @@ package genorm
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"strings"
@@ -19,6 +20,8 @@ type SelectContext[S any, T TablePointer[S]] struct {
 	limit           limitClause
 	offset          offsetClause
 	lockType        lockClause
+	hint            hintClause
+	keyset          keysetClause[T]
 }
 
 func Select[S any, T TablePointer[S]](table T) *SelectContext[S, T] {
@@ -27,7 +30,16 @@ func Select[S any, T TablePointer[S]](table T) *SelectContext[S, T] {
 	}
 }
 
+func (c *SelectContext[S, T]) clone() *SelectContext[S, T] {
+	clone := *c
+	clone.Context = c.Context.clone()
+
+	return &clone
+}
+
 func (c *SelectContext[S, T]) Distinct() *SelectContext[S, T] {
+	c = c.clone()
+
 	if c.distinct {
 		c.addError(errors.New("distinct already set"))
 		return c
@@ -39,6 +51,8 @@ func (c *SelectContext[S, T]) Distinct() *SelectContext[S, T] {
 }
 
 func (c *SelectContext[S, T]) Fields(fields ...TableColumns[T]) *SelectContext[S, T] {
+	c = c.clone()
+
 	if c.fields != nil {
 		c.addError(errors.New("fields already set"))
 		return c
@@ -67,6 +81,8 @@ func (c *SelectContext[S, T]) Fields(fields ...TableColumns[T]) *SelectContext[S
 func (c *SelectContext[S, T]) Where(
 	condition TypedTableExpr[T, WrappedPrimitive[bool]],
 ) *SelectContext[S, T] {
+	c = c.clone()
+
 	err := c.whereCondition.set(condition)
 	if err != nil {
 		c.addError(fmt.Errorf("where condition: %w", err))
@@ -75,7 +91,22 @@ func (c *SelectContext[S, T]) Where(
 	return c
 }
 
+func (c *SelectContext[S, T]) OrWhere(
+	condition TypedTableExpr[T, WrappedPrimitive[bool]],
+) *SelectContext[S, T] {
+	c = c.clone()
+
+	err := c.whereCondition.or(condition)
+	if err != nil {
+		c.addError(fmt.Errorf("where condition: %w", err))
+	}
+
+	return c
+}
+
 func (c *SelectContext[S, T]) GroupBy(exprs ...TableExpr[T]) *SelectContext[S, T] {
+	c = c.clone()
+
 	err := c.groupExpr.set(exprs)
 	if err != nil {
 		c.addError(fmt.Errorf("group by: %w", err))
@@ -87,6 +118,8 @@ func (c *SelectContext[S, T]) GroupBy(exprs ...TableExpr[T]) *SelectContext[S, T
 func (c *SelectContext[S, T]) Having(
 	condition TypedTableExpr[T, WrappedPrimitive[bool]],
 ) *SelectContext[S, T] {
+	c = c.clone()
+
 	err := c.havingCondition.set(condition)
 	if err != nil {
 		c.addError(fmt.Errorf("having condition: %w", err))
@@ -96,6 +129,8 @@ func (c *SelectContext[S, T]) Having(
 }
 
 func (c *SelectContext[S, T]) OrderBy(direction OrderDirection, expr TableExpr[T]) *SelectContext[S, T] {
+	c = c.clone()
+
 	err := c.order.add(orderItem[T]{
 		expr:      expr,
 		direction: direction,
@@ -108,6 +143,8 @@ func (c *SelectContext[S, T]) OrderBy(direction OrderDirection, expr TableExpr[T
 }
 
 func (c *SelectContext[S, T]) Limit(limit uint64) *SelectContext[S, T] {
+	c = c.clone()
+
 	err := c.limit.set(limit)
 	if err != nil {
 		c.addError(fmt.Errorf("limit: %w", err))
@@ -117,6 +154,8 @@ func (c *SelectContext[S, T]) Limit(limit uint64) *SelectContext[S, T] {
 }
 
 func (c *SelectContext[S, T]) Offset(offset uint64) *SelectContext[S, T] {
+	c = c.clone()
+
 	err := c.offset.set(offset)
 	if err != nil {
 		c.addError(fmt.Errorf("offset: %w", err))
@@ -125,8 +164,10 @@ func (c *SelectContext[S, T]) Offset(offset uint64) *SelectContext[S, T] {
 	return c
 }
 
-func (c *SelectContext[S, T]) Lock(lockType LockType) *SelectContext[S, T] {
-	err := c.lockType.set(lockType)
+func (c *SelectContext[S, T]) Lock(lockType LockType, options ...LockOption) *SelectContext[S, T] {
+	c = c.clone()
+
+	err := c.lockType.set(lockType, options...)
 	if err != nil {
 		c.addError(fmt.Errorf("lockType: %w", err))
 	}
@@ -134,6 +175,62 @@ func (c *SelectContext[S, T]) Lock(lockType LockType) *SelectContext[S, T] {
 	return c
 }
 
+// IndexHint index hint for table in the FROM clause(MySQL only)
+func (c *SelectContext[S, T]) IndexHint(table BasicTable, hintType IndexHintType, indexes ...string) *SelectContext[S, T] {
+	c = c.clone()
+
+	err := c.hint.addIndexHint(table, hintType, indexes)
+	if err != nil {
+		c.addError(fmt.Errorf("index hint: %w", err))
+	}
+
+	return c
+}
+
+// OptimizerHint optimizer hint comment(/*+ hint */) after SELECT(MySQL only)
+func (c *SelectContext[S, T]) OptimizerHint(hint string) *SelectContext[S, T] {
+	c = c.clone()
+
+	err := c.hint.addOptimizerHint(hint)
+	if err != nil {
+		c.addError(fmt.Errorf("optimizer hint: %w", err))
+	}
+
+	return c
+}
+
+func (c *SelectContext[S, T]) Dialect(dialect Dialect) *SelectContext[S, T] {
+	c = c.clone()
+
+	c.setDialect(dialect)
+
+	return c
+}
+
+// Paginate keyset pagination ordered by columns. Columns must identify a row uniquely.
+func (c *SelectContext[S, T]) Paginate(size uint64, direction OrderDirection, columns ...TableColumns[T]) *SelectContext[S, T] {
+	c = c.clone()
+
+	err := c.keyset.set(size, direction, columns)
+	if err != nil {
+		c.addError(fmt.Errorf("paginate: %w", err))
+	}
+
+	return c
+}
+
+// After fetch the page after cursor. Empty cursor means the first page.
+func (c *SelectContext[S, T]) After(cursor Cursor) *SelectContext[S, T] {
+	c = c.clone()
+
+	err := c.keyset.setCursor(cursor)
+	if err != nil {
+		c.addError(fmt.Errorf("after: %w", err))
+	}
+
+	return c
+}
+
 func (c *SelectContext[S, T]) GetAllCtx(ctx context.Context, db DB) ([]T, error) {
 	errs := c.Errors()
 	if len(errs) != 0 {
@@ -162,23 +259,18 @@ func (c *SelectContext[S, T]) GetAllCtx(ctx context.Context, db DB) ([]T, error)
 	tables := []T{}
 	for rows.Next() {
 		var table S
-		columnMap := T(&table).ColumnMap()
-
-		dests := make([]any, 0, len(columns))
-		for _, column := range columns {
-			columnField, ok := columnMap[column.SQLColumnName()]
-			if !ok {
-				return nil, fmt.Errorf("column %s not found", column.SQLColumnName())
-			}
-
-			dests = append(dests, columnField)
+		dests, setNullTables, err := c.columnDests(T(&table), columns)
+		if err != nil {
+			return nil, err
 		}
 
-		err := rows.Scan(dests...)
+		err = rows.Scan(dests...)
 		if err != nil {
 			return nil, fmt.Errorf("scan: %w", err)
 		}
 
+		setNullTables()
+
 		tables = append(tables, &table)
 	}
 
@@ -189,20 +281,30 @@ func (c *SelectContext[S, T]) GetAll(db DB) ([]T, error) {
 	return c.GetAllCtx(context.Background(), db)
 }
 
-func (c *SelectContext[S, T]) GetCtx(ctx context.Context, db DB) (T, error) {
-	err := c.limit.set(1)
-	if err != nil {
-		return nil, fmt.Errorf("set limit 1: %w", err)
+// GetCursorPageCtx fetch a page of Paginate. Cursor is empty if the next page does not exist.
+func (c *SelectContext[S, T]) GetCursorPageCtx(ctx context.Context, db DB) ([]T, Cursor, error) {
+	if len(c.keyset.columns) == 0 {
+		return nil, "", errors.New("paginate not set")
 	}
 
 	errs := c.Errors()
 	if len(errs) != 0 {
-		return nil, errs[0]
+		return nil, "", errs[0]
 	}
 
 	columns, query, exprArgs, err := c.buildQuery()
 	if err != nil {
-		return nil, fmt.Errorf("build query: %w", err)
+		return nil, "", fmt.Errorf("build query: %w", err)
+	}
+
+	selectedColumns := make(map[string]struct{}, len(columns))
+	for _, column := range columns {
+		selectedColumns[column.SQLColumnName()] = struct{}{}
+	}
+	for _, column := range c.keyset.columns {
+		if _, ok := selectedColumns[column.SQLColumnName()]; !ok {
+			return nil, "", fmt.Errorf("keyset column %s is not selected", column.SQLColumnName())
+		}
 	}
 
 	args := make([]any, 0, len(exprArgs))
@@ -210,21 +312,311 @@ func (c *SelectContext[S, T]) GetCtx(ctx context.Context, db DB) (T, error) {
 		args = append(args, arg)
 	}
 
-	row := db.QueryRowContext(ctx, query, args...)
+	rows, err := db.QueryContext(ctx, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return []T{}, "", nil
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
 
-	var table S
-	columnMap := T(&table).ColumnMap()
+	tables := []T{}
+	for rows.Next() {
+		if uint64(len(tables)) == c.keyset.size {
+			// the extra row only tells that the next page exists
+			cursor, err := c.nextCursor(tables[len(tables)-1])
+			if err != nil {
+				return nil, "", fmt.Errorf("cursor: %w", err)
+			}
+
+			return tables, cursor, nil
+		}
+
+		var table S
+		dests, setNullTables, err := c.columnDests(T(&table), columns)
+		if err != nil {
+			return nil, "", err
+		}
+
+		err = rows.Scan(dests...)
+		if err != nil {
+			return nil, "", fmt.Errorf("scan: %w", err)
+		}
+
+		setNullTables()
+
+		tables = append(tables, &table)
+	}
+
+	return tables, "", nil
+}
+
+/*
+columnDests
+scan destinations of columns in table.
+columns of the tables outer joined are scanned through outerJoinedScanner,
+and setNullTables records the tables whose columns are all NULL after the scan.
+*/
+func (c *SelectContext[S, T]) columnDests(table T, columns []Column) ([]any, func(), error) {
+	nullableTables := map[string]BasicTable{}
+	if ojt, ok := any(c.table).(OuterJoinedTable); ok {
+		for _, nullableTable := range ojt.NullableTables() {
+			nullableTables[nullableTable.TableName()] = nullableTable
+		}
+	}
+
+	columnMap := table.ColumnMap()
 
 	dests := make([]any, 0, len(columns))
+	outerJoinedScanners := map[string][]*outerJoinedScanner{}
 	for _, column := range columns {
 		columnField, ok := columnMap[column.SQLColumnName()]
 		if !ok {
-			return nil, fmt.Errorf("column %s not found", column)
+			return nil, nil, fmt.Errorf("column %s not found", column.SQLColumnName())
+		}
+
+		if _, ok := nullableTables[column.TableName()]; ok {
+			scanner := &outerJoinedScanner{
+				scanner: columnField,
+			}
+			outerJoinedScanners[column.TableName()] = append(outerJoinedScanners[column.TableName()], scanner)
+			dests = append(dests, scanner)
+			continue
 		}
 
 		dests = append(dests, columnField)
 	}
 
+	setNullTables := func() {
+		setter, ok := any(table).(NullTableSetter)
+		if !ok {
+			return
+		}
+
+		for tableName, scanners := range outerJoinedScanners {
+			isNull := true
+			for _, scanner := range scanners {
+				if !scanner.null {
+					isNull = false
+					break
+				}
+			}
+
+			if isNull {
+				setter.SetNull(nullableTables[tableName])
+			}
+		}
+	}
+
+	return dests, setNullTables, nil
+}
+
+func (c *SelectContext[S, T]) GetCursorPage(db DB) ([]T, Cursor, error) {
+	return c.GetCursorPageCtx(context.Background(), db)
+}
+
+func (c *SelectContext[S, T]) nextCursor(table T) (Cursor, error) {
+	columnMap := table.ColumnMap()
+
+	values := make([]driver.Value, 0, len(c.keyset.columns))
+	for _, column := range c.keyset.columns {
+		columnField, ok := columnMap[column.SQLColumnName()]
+		if !ok {
+			return "", fmt.Errorf("column %s not found", column.SQLColumnName())
+		}
+
+		value, err := columnField.Value()
+		if err != nil {
+			return "", fmt.Errorf("column %s value: %w", column.SQLColumnName(), err)
+		}
+
+		values = append(values, value)
+	}
+
+	return encodeCursor(values)
+}
+
+// GetPageCtx fetch page(1-based) with LIMIT/OFFSET and the total count
+func (c *SelectContext[S, T]) GetPageCtx(ctx context.Context, db DB, page uint64, size uint64) (*Page[T], error) {
+	pageContext, err := c.pageContext(page, size)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := c.CountCtx(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("count: %w", err)
+	}
+
+	items, err := pageContext.GetAllCtx(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("get all: %w", err)
+	}
+
+	return &Page[T]{
+		Items:   items,
+		Total:   total,
+		HasNext: page*size < uint64(total),
+	}, nil
+}
+
+// pageContext the context to get the items of page(1-based) with LIMIT/OFFSET
+func (c *SelectContext[S, T]) pageContext(page uint64, size uint64) (*SelectContext[S, T], error) {
+	if page == 0 {
+		return nil, errors.New("invalid page")
+	}
+	if size == 0 {
+		return nil, errors.New("invalid page size")
+	}
+	if c.limit.exists() || c.offset.exists() {
+		return nil, errors.New("limit and offset cannot be used with GetPage")
+	}
+	if c.keyset.exists() {
+		return nil, errors.New("paginate cannot be used with GetPage")
+	}
+
+	pageContext := c.
+		Limit(size).
+		Offset((page - 1) * size)
+
+	errs := pageContext.Errors()
+	if len(errs) != 0 {
+		return nil, errs[0]
+	}
+
+	return pageContext, nil
+}
+
+func (c *SelectContext[S, T]) GetPage(db DB, page uint64, size uint64) (*Page[T], error) {
+	return c.GetPageCtx(context.Background(), db, page, size)
+}
+
+// GetPageTxCtx GetPageCtx in a read-only transaction, so that Items and Total are consistent
+func (c *SelectContext[S, T]) GetPageTxCtx(ctx context.Context, db TxBeginner, page uint64, size uint64) (res *Page[T], err error) {
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelRepeatableRead,
+		ReadOnly:  true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback()
+			if rollbackErr != nil {
+				err = fmt.Errorf("rollback(%s): %w", rollbackErr.Error(), err)
+			}
+		}
+	}()
+
+	res, err = c.GetPageCtx(ctx, tx, page, size)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return res, nil
+}
+
+func (c *SelectContext[S, T]) GetPageTx(db TxBeginner, page uint64, size uint64) (*Page[T], error) {
+	return c.GetPageTxCtx(context.Background(), db, page, size)
+}
+
+// CountCtx count records matching the query. ORDER BY, LIMIT, OFFSET and LOCK are ignored.
+func (c *SelectContext[S, T]) CountCtx(ctx context.Context, db DB) (int64, error) {
+	errs := c.Errors()
+	if len(errs) != 0 {
+		return 0, errs[0]
+	}
+
+	query, exprArgs, err := c.buildCountQuery()
+	if err != nil {
+		return 0, fmt.Errorf("build query: %w", err)
+	}
+
+	args := make([]any, 0, len(exprArgs))
+	for _, arg := range exprArgs {
+		args = append(args, arg)
+	}
+
+	var count int64
+	err = db.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("query: %w", err)
+	}
+
+	return count, nil
+}
+
+func (c *SelectContext[S, T]) Count(db DB) (int64, error) {
+	return c.CountCtx(context.Background(), db)
+}
+
+// ExistsCtx check if any record matches the query. ORDER BY, LIMIT, OFFSET and LOCK are ignored.
+func (c *SelectContext[S, T]) ExistsCtx(ctx context.Context, db DB) (bool, error) {
+	errs := c.Errors()
+	if len(errs) != 0 {
+		return false, errs[0]
+	}
+
+	query, exprArgs, err := c.buildExistsQuery()
+	if err != nil {
+		return false, fmt.Errorf("build query: %w", err)
+	}
+
+	args := make([]any, 0, len(exprArgs))
+	for _, arg := range exprArgs {
+		args = append(args, arg)
+	}
+
+	var exists bool
+	err = db.QueryRowContext(ctx, query, args...).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("query: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (c *SelectContext[S, T]) Exists(db DB) (bool, error) {
+	return c.ExistsCtx(context.Background(), db)
+}
+
+func (c *SelectContext[S, T]) GetCtx(ctx context.Context, db DB) (T, error) {
+	c = c.clone()
+	err := c.limit.set(1)
+	if err != nil {
+		return nil, fmt.Errorf("set limit 1: %w", err)
+	}
+
+	errs := c.Errors()
+	if len(errs) != 0 {
+		return nil, errs[0]
+	}
+
+	columns, query, exprArgs, err := c.buildQuery()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	args := make([]any, 0, len(exprArgs))
+	for _, arg := range exprArgs {
+		args = append(args, arg)
+	}
+
+	row := db.QueryRowContext(ctx, query, args...)
+
+	var table S
+	dests, setNullTables, err := c.columnDests(T(&table), columns)
+	if err != nil {
+		return nil, err
+	}
+
 	err = row.Scan(dests...)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, ErrRecordNotFound
@@ -233,6 +625,8 @@ func (c *SelectContext[S, T]) GetCtx(ctx context.Context, db DB) (T, error) {
 		return nil, fmt.Errorf("query: %w", err)
 	}
 
+	setNullTables()
+
 	return &table, nil
 }
 
@@ -241,23 +635,6 @@ func (c *SelectContext[S, T]) Get(db DB) (T, error) {
 }
 
 func (c *SelectContext[S, T]) buildQuery() ([]Column, string, []ExprType, error) {
-	sb := strings.Builder{}
-	args := []ExprType{}
-
-	str := "SELECT "
-	_, err := sb.WriteString(str)
-	if err != nil {
-		return nil, "", nil, fmt.Errorf("write string(%s): %w", str, err)
-	}
-
-	if c.distinct {
-		str = "DISTINCT "
-		_, err = sb.WriteString(str)
-		if err != nil {
-			return nil, "", nil, fmt.Errorf("write string(%s): %w", str, err)
-		}
-	}
-
 	var columns []Column
 	if len(c.fields) == 0 {
 		columns = c.table.Columns()
@@ -282,45 +659,102 @@ func (c *SelectContext[S, T]) buildQuery() ([]Column, string, []ExprType, error)
 		selectExprs = append(selectExprs, fmt.Sprintf("%s AS %s", column.SQLColumnName(), alias))
 	}
 
-	str = strings.Join(selectExprs, ", ")
+	query, args, err := c.buildSelectQuery(strings.Join(selectExprs, ", "), nil)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	return columns, query, args, nil
+}
+
+// buildSelectQuery SELECT selectQuery FROM table WHERE ... with the clauses of the context
+func (c *SelectContext[S, T]) buildSelectQuery(selectQuery string, selectArgs []ExprType) (string, []ExprType, error) {
+	whereCondition, order, limit := c.whereCondition, c.order, c.limit
+	if c.keyset.exists() {
+		err := c.keyset.apply(c.dialect, &whereCondition, &order, &limit, &c.offset)
+		if err != nil {
+			return "", nil, fmt.Errorf("paginate: %w", err)
+		}
+	}
+
+	sb := strings.Builder{}
+	args := []ExprType{}
+
+	str := "SELECT "
+	_, err := sb.WriteString(str)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+	}
+
+	err = c.hint.validate(c.dialect)
+	if err != nil {
+		return "", nil, fmt.Errorf("hint: %w", err)
+	}
+
+	str = c.hint.getOptimizerHintExpr()
 	_, err = sb.WriteString(str)
 	if err != nil {
-		return nil, "", nil, fmt.Errorf("write string(%s): %w", str, err)
+		return "", nil, fmt.Errorf("write string(%s): %w", str, err)
 	}
 
+	if c.distinct {
+		str = "DISTINCT "
+		_, err = sb.WriteString(str)
+		if err != nil {
+			return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+		}
+	}
+
+	_, err = sb.WriteString(selectQuery)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", selectQuery, err)
+	}
+
+	args = append(args, selectArgs...)
+
 	str = " FROM "
 	_, err = sb.WriteString(str)
 	if err != nil {
-		return nil, "", nil, fmt.Errorf("write string(%s): %w", str, err)
+		return "", nil, fmt.Errorf("write string(%s): %w", str, err)
 	}
 
-	tableQuery, tableArgs, errs := c.table.Expr()
-	if len(errs) != 0 {
-		return nil, "", nil, fmt.Errorf("table expr: %w", errs[0])
+	indexHints, err := c.hint.newIndexHints()
+	if err != nil {
+		return "", nil, fmt.Errorf("index hint: %w", err)
+	}
+
+	tableQuery, tableArgs, err := c.indexHintTableExpr(indexHints)
+	if err != nil {
+		return "", nil, fmt.Errorf("table expr: %w", err)
+	}
+
+	err = indexHints.validate()
+	if err != nil {
+		return "", nil, fmt.Errorf("index hint: %w", err)
 	}
 
 	_, err = sb.WriteString(tableQuery)
 	if err != nil {
-		return nil, "", nil, fmt.Errorf("write string(%s): %w", tableQuery, err)
+		return "", nil, fmt.Errorf("write string(%s): %w", tableQuery, err)
 	}
 
 	args = append(args, tableArgs...)
 
-	if c.whereCondition.exists() {
-		whereQuery, whereArgs, err := c.whereCondition.getExpr()
+	if whereCondition.exists() {
+		whereQuery, whereArgs, err := whereCondition.getExpr()
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("where condition: %w", err)
+			return "", nil, fmt.Errorf("where condition: %w", err)
 		}
 
 		str = " WHERE "
 		_, err = sb.WriteString(str)
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("write string(%s): %w", str, err)
+			return "", nil, fmt.Errorf("write string(%s): %w", str, err)
 		}
 
 		_, err = sb.WriteString(whereQuery)
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("write string(%s): %w", whereQuery, err)
+			return "", nil, fmt.Errorf("write string(%s): %w", whereQuery, err)
 		}
 
 		args = append(args, whereArgs...)
@@ -329,18 +763,18 @@ func (c *SelectContext[S, T]) buildQuery() ([]Column, string, []ExprType, error)
 	if c.groupExpr.exists() {
 		groupExpr, groupArgs, err := c.groupExpr.getExpr()
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("group expr: %w", err)
+			return "", nil, fmt.Errorf("group expr: %w", err)
 		}
 
 		str = " "
 		_, err = sb.WriteString(str)
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("write string(%s): %w", str, err)
+			return "", nil, fmt.Errorf("write string(%s): %w", str, err)
 		}
 
 		_, err = sb.WriteString(groupExpr)
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("write string(%s): %w", groupExpr, err)
+			return "", nil, fmt.Errorf("write string(%s): %w", groupExpr, err)
 		}
 
 		args = append(args, groupArgs...)
@@ -349,58 +783,58 @@ func (c *SelectContext[S, T]) buildQuery() ([]Column, string, []ExprType, error)
 	if c.havingCondition.exists() {
 		havingQuery, havingArgs, err := c.havingCondition.getExpr()
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("having condition: %w", err)
+			return "", nil, fmt.Errorf("having condition: %w", err)
 		}
 
 		str = " HAVING "
 		_, err = sb.WriteString(str)
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("write string(%s): %w", str, err)
+			return "", nil, fmt.Errorf("write string(%s): %w", str, err)
 		}
 
 		_, err = sb.WriteString(havingQuery)
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("write string(%s): %w", havingQuery, err)
+			return "", nil, fmt.Errorf("write string(%s): %w", havingQuery, err)
 		}
 
 		args = append(args, havingArgs...)
 	}
 
-	if c.order.exists() {
-		orderQuery, orderArgs, err := c.order.getExpr()
+	if order.exists() {
+		orderQuery, orderArgs, err := order.getExpr()
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("order: %w", err)
+			return "", nil, fmt.Errorf("order: %w", err)
 		}
 
 		str = " "
 		_, err = sb.WriteString(str)
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("write string(%s): %w", str, err)
+			return "", nil, fmt.Errorf("write string(%s): %w", str, err)
 		}
 
 		_, err = sb.WriteString(orderQuery)
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("write string(%s): %w", orderQuery, err)
+			return "", nil, fmt.Errorf("write string(%s): %w", orderQuery, err)
 		}
 
 		args = append(args, orderArgs...)
 	}
 
-	if c.limit.exists() {
-		limitQuery, limitArgs, err := c.limit.getExpr()
+	if limit.exists() {
+		limitQuery, limitArgs, err := limit.getExpr()
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("limit: %w", err)
+			return "", nil, fmt.Errorf("limit: %w", err)
 		}
 
 		str = " "
 		_, err = sb.WriteString(str)
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("write string(%s): %w", str, err)
+			return "", nil, fmt.Errorf("write string(%s): %w", str, err)
 		}
 
 		_, err = sb.WriteString(limitQuery)
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("write string(%s): %w", limitQuery, err)
+			return "", nil, fmt.Errorf("write string(%s): %w", limitQuery, err)
 		}
 
 		args = append(args, limitArgs...)
@@ -409,42 +843,179 @@ func (c *SelectContext[S, T]) buildQuery() ([]Column, string, []ExprType, error)
 	if c.offset.exists() {
 		offsetQuery, offsetArgs, err := c.offset.getExpr()
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("offset: %w", err)
+			return "", nil, fmt.Errorf("offset: %w", err)
 		}
 
 		str = " "
 		_, err = sb.WriteString(str)
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("write string(%s): %w", str, err)
+			return "", nil, fmt.Errorf("write string(%s): %w", str, err)
 		}
 
 		_, err = sb.WriteString(offsetQuery)
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("write string(%s): %w", offsetQuery, err)
+			return "", nil, fmt.Errorf("write string(%s): %w", offsetQuery, err)
 		}
 
 		args = append(args, offsetArgs...)
 	}
 
 	if c.lockType.exists() {
+		err = c.lockType.validate(c.dialect, c.table)
+		if err != nil {
+			return "", nil, fmt.Errorf("lock type: %w", err)
+		}
+
 		lockQuery, lockArgs, err := c.lockType.getExpr()
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("lock: %w", err)
+			return "", nil, fmt.Errorf("lock: %w", err)
 		}
 
 		str = " "
 		_, err = sb.WriteString(str)
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("write string(%s): %w", str, err)
+			return "", nil, fmt.Errorf("write string(%s): %w", str, err)
 		}
 
 		_, err = sb.WriteString(lockQuery)
 		if err != nil {
-			return nil, "", nil, fmt.Errorf("write string(%s): %w", lockQuery, err)
+			return "", nil, fmt.Errorf("write string(%s): %w", lockQuery, err)
 		}
 
 		args = append(args, lockArgs...)
 	}
 
-	return columns, sb.String(), args, nil
+	return c.resolveDialectExprs(sb.String(), args)
+}
+
+// countBaseContext context without ORDER BY, LIMIT, OFFSET, LOCK and paginate
+func (c *SelectContext[S, T]) countBaseContext() *SelectContext[S, T] {
+	countContext := *c
+	countContext.order = orderClause[T]{}
+	countContext.limit = limitClause{}
+	countContext.offset = offsetClause{}
+	countContext.lockType = lockClause{}
+	countContext.keyset = keysetClause[T]{}
+	// optimizer hints are written in the outermost SELECT
+	countContext.hint.optimizerHints = nil
+
+	return &countContext
+}
+
+/*
+buildCountQuery
+if (distinct || group by || having) {return SELECT COUNT(*) FROM (SELECT ...) AS genorm_count}
+else {return SELECT COUNT(*) FROM table WHERE ...}
+*/
+func (c *SelectContext[S, T]) buildCountQuery() (string, []ExprType, error) {
+	err := c.hint.validate(c.dialect)
+	if err != nil {
+		return "", nil, fmt.Errorf("hint: %w", err)
+	}
+
+	optimizerHint := c.hint.getOptimizerHintExpr()
+	countContext := c.countBaseContext()
+
+	if countContext.distinct || countContext.groupExpr.exists() || countContext.havingCondition.exists() {
+		_, subQuery, subQueryArgs, err := countContext.buildQuery()
+		if err != nil {
+			return "", nil, fmt.Errorf("sub query: %w", err)
+		}
+
+		return fmt.Sprintf("SELECT %sCOUNT(*) FROM (%s) AS genorm_count", optimizerHint, subQuery), subQueryArgs, nil
+	}
+
+	fromQuery, args, err := countContext.buildFromQuery()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return fmt.Sprintf("SELECT %sCOUNT(*)%s", optimizerHint, fromQuery), args, nil
+}
+
+/*
+buildExistsQuery
+if (distinct || group by || having) {return SELECT EXISTS(SELECT ...)}
+else {return SELECT EXISTS(SELECT 1 FROM table WHERE ...)}
+*/
+func (c *SelectContext[S, T]) buildExistsQuery() (string, []ExprType, error) {
+	err := c.hint.validate(c.dialect)
+	if err != nil {
+		return "", nil, fmt.Errorf("hint: %w", err)
+	}
+
+	optimizerHint := c.hint.getOptimizerHintExpr()
+	existsContext := c.countBaseContext()
+
+	if existsContext.distinct || existsContext.groupExpr.exists() || existsContext.havingCondition.exists() {
+		_, subQuery, subQueryArgs, err := existsContext.buildQuery()
+		if err != nil {
+			return "", nil, fmt.Errorf("sub query: %w", err)
+		}
+
+		return fmt.Sprintf("SELECT %sEXISTS(%s)", optimizerHint, subQuery), subQueryArgs, nil
+	}
+
+	fromQuery, args, err := existsContext.buildFromQuery()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return fmt.Sprintf("SELECT %sEXISTS(SELECT 1%s)", optimizerHint, fromQuery), args, nil
+}
+
+// buildFromQuery " FROM table WHERE ..."
+func (c *SelectContext[S, T]) buildFromQuery() (string, []ExprType, error) {
+	sb := strings.Builder{}
+	args := []ExprType{}
+
+	str := " FROM "
+	_, err := sb.WriteString(str)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+	}
+
+	indexHints, err := c.hint.newIndexHints()
+	if err != nil {
+		return "", nil, fmt.Errorf("index hint: %w", err)
+	}
+
+	tableQuery, tableArgs, err := c.indexHintTableExpr(indexHints)
+	if err != nil {
+		return "", nil, fmt.Errorf("table expr: %w", err)
+	}
+
+	err = indexHints.validate()
+	if err != nil {
+		return "", nil, fmt.Errorf("index hint: %w", err)
+	}
+
+	_, err = sb.WriteString(tableQuery)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", tableQuery, err)
+	}
+
+	args = append(args, tableArgs...)
+
+	if c.whereCondition.exists() {
+		whereQuery, whereArgs, err := c.whereCondition.getExpr()
+		if err != nil {
+			return "", nil, fmt.Errorf("where condition: %w", err)
+		}
+
+		str = " WHERE "
+		_, err = sb.WriteString(str)
+		if err != nil {
+			return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+		}
+
+		_, err = sb.WriteString(whereQuery)
+		if err != nil {
+			return "", nil, fmt.Errorf("write string(%s): %w", whereQuery, err)
+		}
+
+		args = append(args, whereArgs...)
+	}
+
+	return c.resolveDialectExprs(sb.String(), args)
 }
@@ -3,3 +3,24 @@ package genorm
 func (c *SelectContext[_, _]) BuildQuery() ([]Column, string, []ExprType, error) {
 	return c.buildQuery()
 }
+
+func (c *SelectContext[_, _]) BuildCountQuery() (string, []ExprType, error) {
+	return c.buildCountQuery()
+}
+
+func (c *SelectContext[_, _]) BuildPageQuery(page uint64, size uint64) ([]Column, string, []ExprType, error) {
+	pageContext, err := c.pageContext(page, size)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	return pageContext.buildQuery()
+}
+
+func (c *SelectContext[_, _]) BuildExistsQuery() (string, []ExprType, error) {
+	return c.buildExistsQuery()
+}
+
+func (c *SelectContext[_, T]) ColumnDests(table T, columns []Column) ([]any, func(), error) {
+	return c.columnDests(table, columns)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/mazrean/genorm"
 	"github.com/mazrean/genorm/mock"
+	"github.com/mazrean/genorm/relation"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -39,6 +40,8 @@ func TestSelectBuildQuery(t *testing.T) {
 		groupExprs      []expr
 		havingCondition *expr
 		whereCondition  *expr
+		andWhereConds   []expr
+		orWhereConds    []expr
 		orderItems      []orderItem
 		limit           uint64
 		offset          uint64
@@ -332,6 +335,81 @@ func TestSelectBuildQuery(t *testing.T) {
 			},
 			err: true,
 		},
+		{
+			description: "multiple where",
+			tableExpr: expr{
+				query: "hoge",
+			},
+			fields: []field{
+				{
+					tableName:     "hoge",
+					columnName:    "huga",
+					sqlColumnName: "hoge.huga",
+				},
+			},
+			whereCondition: &expr{
+				query: "(hoge.huga = ?)",
+				args:  []genorm.ExprType{genorm.Wrap(1)},
+			},
+			andWhereConds: []expr{
+				{
+					query: "(hoge.piyo = ?)",
+					args:  []genorm.ExprType{genorm.Wrap(2)},
+				},
+			},
+			orWhereConds: []expr{
+				{
+					query: "(hoge.huga = ?)",
+					args:  []genorm.ExprType{genorm.Wrap(3)},
+				},
+			},
+			query: "SELECT hoge.huga AS hoge_huga_0 FROM hoge WHERE (((hoge.huga = ?) AND (hoge.piyo = ?)) OR (hoge.huga = ?))",
+			args:  []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(2), genorm.Wrap(3)},
+		},
+		{
+			description: "or where without where",
+			tableExpr: expr{
+				query: "hoge",
+			},
+			fields: []field{
+				{
+					tableName:     "hoge",
+					columnName:    "huga",
+					sqlColumnName: "hoge.huga",
+				},
+			},
+			orWhereConds: []expr{
+				{
+					query: "(hoge.huga = ?)",
+					args:  []genorm.ExprType{genorm.Wrap(1)},
+				},
+			},
+			query: "SELECT hoge.huga AS hoge_huga_0 FROM hoge WHERE (hoge.huga = ?)",
+			args:  []genorm.ExprType{genorm.Wrap(1)},
+		},
+		{
+			description: "and where error",
+			tableExpr: expr{
+				query: "hoge",
+			},
+			fields: []field{
+				{
+					tableName:     "hoge",
+					columnName:    "huga",
+					sqlColumnName: "hoge.huga",
+				},
+			},
+			whereCondition: &expr{
+				query: "(hoge.huga = ?)",
+				args:  []genorm.ExprType{genorm.Wrap(1)},
+			},
+			andWhereConds: []expr{
+				{
+					errs: []error{errors.New("where error")},
+				},
+			},
+			err: true,
+		},
 		{
 			description: "order by",
 			tableExpr: expr{
@@ -513,7 +591,7 @@ func TestSelectBuildQuery(t *testing.T) {
 					fields = append(fields, mockColumn)
 				}
 
-				builder.Fields(tableFields...)
+				builder = builder.Fields(tableFields...)
 			} else {
 				for _, field := range test.fields {
 					mockColumn := mock.NewMockColumn(ctrl)
@@ -578,6 +656,26 @@ func TestSelectBuildQuery(t *testing.T) {
 				builder = builder.Where(mockExpr)
 			}
 
+			for _, condition := range test.andWhereConds {
+				mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return(condition.query, condition.args, condition.errs)
+
+				builder = builder.Where(mockExpr)
+			}
+
+			for _, condition := range test.orWhereConds {
+				mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return(condition.query, condition.args, condition.errs)
+
+				builder = builder.OrWhere(mockExpr)
+			}
+
 			for _, orderItem := range test.orderItems {
 				mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
 				mockExpr.
@@ -616,3 +714,401 @@ func TestSelectBuildQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectBuildExistsQuery(t *testing.T) {
+	t.Parallel()
+
+	type expr struct {
+		query string
+		args  []genorm.ExprType
+		errs  []error
+	}
+
+	tests := []struct {
+		description    string
+		tableExpr      expr
+		distinct       bool
+		whereCondition *expr
+		groupExpr      *expr
+		limit          uint64
+		lockType       genorm.LockType
+		query          string
+		args           []genorm.ExprType
+		err            bool
+	}{
+		{
+			description: "normal",
+			tableExpr: expr{
+				query: "hoge",
+			},
+			query: "SELECT EXISTS(SELECT 1 FROM hoge)",
+			args:  []genorm.ExprType{},
+		},
+		{
+			description: "joined table and where",
+			tableExpr: expr{
+				query: "hoge JOIN fuga ON hoge.id = fuga.id AND hoge.huga = ?",
+				args:  []genorm.ExprType{genorm.Wrap(1)},
+			},
+			whereCondition: &expr{
+				query: "(fuga.piyo = ?)",
+				args:  []genorm.ExprType{genorm.Wrap(2)},
+			},
+			query: "SELECT EXISTS(SELECT 1 FROM hoge JOIN fuga ON hoge.id = fuga.id AND hoge.huga = ? WHERE (fuga.piyo = ?))",
+			args:  []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(2)},
+		},
+		{
+			description: "limit and lock are dropped",
+			tableExpr: expr{
+				query: "hoge",
+			},
+			limit:    10,
+			lockType: genorm.ForShare,
+			query:    "SELECT EXISTS(SELECT 1 FROM hoge)",
+			args:     []genorm.ExprType{},
+		},
+		{
+			description: "distinct",
+			tableExpr: expr{
+				query: "hoge",
+			},
+			distinct: true,
+			query:    "SELECT EXISTS(SELECT DISTINCT hoge.huga AS hoge_huga_0 FROM hoge)",
+			args:     []genorm.ExprType{},
+		},
+		{
+			description: "group by",
+			tableExpr: expr{
+				query: "hoge",
+			},
+			groupExpr: &expr{
+				query: "hoge.huga",
+			},
+			query: "SELECT EXISTS(SELECT hoge.huga AS hoge_huga_0 FROM hoge GROUP BY hoge.huga)",
+			args:  []genorm.ExprType{},
+		},
+		{
+			description: "table error",
+			tableExpr: expr{
+				errs: []error{errors.New("table error")},
+			},
+			err: true,
+		},
+		{
+			description: "where error",
+			tableExpr: expr{
+				query: "hoge",
+			},
+			whereCondition: &expr{
+				errs: []error{errors.New("where error")},
+			},
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			table := mock.NewMockTable(ctrl)
+			table.
+				EXPECT().
+				Expr().
+				Return(test.tableExpr.query, test.tableExpr.args, test.tableExpr.errs)
+			table.
+				EXPECT().
+				GetErrors().
+				Return(nil)
+
+			mockColumn := mock.NewMockColumn(ctrl)
+			mockColumn.EXPECT().TableName().Return("hoge").AnyTimes()
+			mockColumn.EXPECT().ColumnName().Return("huga").AnyTimes()
+			mockColumn.EXPECT().SQLColumnName().Return("hoge.huga").AnyTimes()
+			table.
+				EXPECT().
+				Columns().
+				Return([]genorm.Column{mockColumn}).
+				AnyTimes()
+
+			builder := genorm.Select(table)
+
+			if test.distinct {
+				builder = builder.Distinct()
+			}
+
+			if test.whereCondition != nil {
+				mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return(test.whereCondition.query, test.whereCondition.args, test.whereCondition.errs)
+
+				builder = builder.Where(mockExpr)
+			}
+
+			if test.groupExpr != nil {
+				mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return(test.groupExpr.query, test.groupExpr.args, test.groupExpr.errs)
+
+				builder = builder.GroupBy(mockExpr)
+			}
+
+			if test.limit != 0 {
+				builder = builder.Limit(test.limit)
+			}
+
+			if test.lockType != 0 {
+				builder = builder.Lock(test.lockType)
+			}
+
+			query, args, err := builder.BuildExistsQuery()
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+			assert.Equal(t, test.args, args)
+		})
+	}
+}
+
+func TestSelectLockDialect(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		dialect     genorm.Dialect
+		lockType    genorm.LockType
+		options     []genorm.LockOption
+		query       string
+		err         bool
+	}{
+		{
+			description: "mysql skip locked",
+			dialect:     genorm.MySQL,
+			lockType:    genorm.ForUpdate,
+			options:     []genorm.LockOption{genorm.SkipLocked()},
+			query:       "SELECT hoge.huga AS hoge_huga_0 FROM hoge FOR UPDATE SKIP LOCKED",
+		},
+		{
+			description: "postgres for no key update nowait",
+			dialect:     genorm.PostgreSQL,
+			lockType:    genorm.ForNoKeyUpdate,
+			options:     []genorm.LockOption{genorm.NoWait()},
+			query:       "SELECT hoge.huga AS hoge_huga_0 FROM hoge FOR NO KEY UPDATE NOWAIT",
+		},
+		{
+			description: "mysql for no key update",
+			dialect:     genorm.MySQL,
+			lockType:    genorm.ForNoKeyUpdate,
+			err:         true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			table := mock.NewMockTable(ctrl)
+			table.
+				EXPECT().
+				Expr().
+				Return("hoge", nil, nil).
+				AnyTimes()
+			table.
+				EXPECT().
+				GetErrors().
+				Return(nil)
+
+			mockColumn := mock.NewMockColumn(ctrl)
+			mockColumn.EXPECT().TableName().Return("hoge").AnyTimes()
+			mockColumn.EXPECT().ColumnName().Return("huga").AnyTimes()
+			mockColumn.EXPECT().SQLColumnName().Return("hoge.huga").AnyTimes()
+			table.
+				EXPECT().
+				Columns().
+				Return([]genorm.Column{mockColumn}).
+				AnyTimes()
+
+			_, query, _, err := genorm.
+				Select(table).
+				Dialect(test.dialect).
+				Lock(test.lockType, test.options...).
+				BuildQuery()
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+		})
+	}
+}
+
+func TestSelectImmutable(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	table := mock.NewMockTable(ctrl)
+	table.
+		EXPECT().
+		Expr().
+		Return("hoge", nil, nil).
+		AnyTimes()
+	table.
+		EXPECT().
+		GetErrors().
+		Return(nil)
+
+	mockColumn := mock.NewMockColumn(ctrl)
+	mockColumn.EXPECT().TableName().Return("hoge").AnyTimes()
+	mockColumn.EXPECT().ColumnName().Return("huga").AnyTimes()
+	mockColumn.EXPECT().SQLColumnName().Return("hoge.huga").AnyTimes()
+	table.
+		EXPECT().
+		Columns().
+		Return([]genorm.Column{mockColumn}).
+		AnyTimes()
+
+	newExpr := func(query string, args ...genorm.ExprType) genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]] {
+		mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+		mockExpr.
+			EXPECT().
+			Expr().
+			Return(query, args, nil).
+			AnyTimes()
+
+		return mockExpr
+	}
+
+	base := genorm.
+		Select(table).
+		Where(newExpr("(hoge.huga = ?)", genorm.Wrap(1))).
+		OrderBy(genorm.Asc, newExpr("hoge.huga"))
+
+	tests := []struct {
+		description string
+		builder     *genorm.SelectContext[mock.MockTable, *mock.MockTable]
+		query       string
+		args        []genorm.ExprType
+		isError     bool
+	}{
+		{
+			description: "extended where",
+			builder:     base.Where(newExpr("(hoge.piyo = ?)", genorm.Wrap(2))),
+			query:       "SELECT hoge.huga AS hoge_huga_0 FROM hoge WHERE ((hoge.huga = ?) AND (hoge.piyo = ?)) ORDER BY hoge.huga ASC",
+			args:        []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(2)},
+		},
+		{
+			description: "extended order by",
+			builder:     base.OrderBy(genorm.Desc, newExpr("hoge.piyo")),
+			query:       "SELECT hoge.huga AS hoge_huga_0 FROM hoge WHERE (hoge.huga = ?) ORDER BY hoge.huga ASC, hoge.piyo DESC",
+			args:        []genorm.ExprType{genorm.Wrap(1)},
+		},
+		{
+			description: "limit overridden",
+			builder:     base.Limit(10).Limit(20),
+			query:       "SELECT hoge.huga AS hoge_huga_0 FROM hoge WHERE (hoge.huga = ?) ORDER BY hoge.huga ASC LIMIT 20",
+			args:        []genorm.ExprType{genorm.Wrap(1)},
+		},
+		{
+			description: "limit and offset overridden by 0",
+			builder:     base.Limit(10).Offset(5).Limit(0).Offset(0),
+			query:       "SELECT hoge.huga AS hoge_huga_0 FROM hoge WHERE (hoge.huga = ?) ORDER BY hoge.huga ASC LIMIT 0 OFFSET 0",
+			args:        []genorm.ExprType{genorm.Wrap(1)},
+		},
+		{
+			description: "error in derived builder",
+			builder:     base.Distinct().Distinct(),
+			isError:     true,
+		},
+		{
+			description: "base is not changed",
+			builder:     base,
+			query:       "SELECT hoge.huga AS hoge_huga_0 FROM hoge WHERE (hoge.huga = ?) ORDER BY hoge.huga ASC",
+			args:        []genorm.ExprType{genorm.Wrap(1)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			if test.isError {
+				assert.NotEmpty(t, test.builder.Errors())
+				return
+			}
+
+			if !assert.Empty(t, test.builder.Errors()) {
+				return
+			}
+
+			_, query, args, err := test.builder.BuildQuery()
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+			assert.Equal(t, test.args, args)
+		})
+	}
+}
+
+func TestSelectFullJoinDialect(t *testing.T) {
+	t.Parallel()
+
+	pair := relation.Pair(&aliasTestUser{}, genorm.As[aliasTestManager](&aliasTestUser{}))
+	joinedTable := pair.FullJoin(genorm.Eq(
+		relation.LeftExpr(pair, aliasTestUserID),
+		relation.RightExpr(pair, genorm.AliasColumn[aliasTestManager](aliasTestUserID)),
+	))
+
+	tests := []struct {
+		description string
+		dialect     genorm.Dialect
+		query       string
+		isError     bool
+	}{
+		{
+			description: "mysql",
+			dialect:     genorm.MySQL,
+			isError:     true,
+		},
+		{
+			description: "postgres",
+			dialect:     genorm.PostgreSQL,
+			query:       "SELECT users.id AS users_id_0 FROM (users FULL OUTER JOIN users AS manager ON (users.id = manager.id))",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			_, query, _, err := genorm.
+				Select(joinedTable).
+				Fields(relation.Left(pair, aliasTestUserID)).
+				Dialect(test.dialect).
+				BuildQuery()
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package genorm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// String Functions
+
+// Concat CONCAT(expr1, expr2, ...)
+func Concat[T Table](exprs ...TypedTableExpr[T, WrappedPrimitive[string]]) TypedTableExpr[T, WrappedPrimitive[string]] {
+	if len(exprs) == 0 {
+		return &ExprStruct[T, WrappedPrimitive[string]]{
+			errs: []error{errors.New("concat exprs are empty")},
+		}
+	}
+
+	queries := make([]string, 0, len(exprs))
+	args := []ExprType{}
+	for _, expr := range exprs {
+		if expr == nil {
+			return &ExprStruct[T, WrappedPrimitive[string]]{
+				errs: []error{errors.New("concat expr is nil")},
+			}
+		}
+
+		query, exprArgs, errs := expr.Expr()
+		if len(errs) != 0 {
+			return &ExprStruct[T, WrappedPrimitive[string]]{
+				errs: errs,
+			}
+		}
+
+		queries = append(queries, query)
+		args = append(args, exprArgs...)
+	}
+
+	return &ExprStruct[T, WrappedPrimitive[string]]{
+		query: fmt.Sprintf("CONCAT(%s)", strings.Join(queries, ", ")),
+		args:  args,
+	}
+}
+
+// Lower LOWER(expr)
+func Lower[T Table](expr TypedTableExpr[T, WrappedPrimitive[string]]) TypedTableExpr[T, WrappedPrimitive[string]] {
+	return stringFunction[T, WrappedPrimitive[string]]("LOWER", expr)
+}
+
+// Upper UPPER(expr)
+func Upper[T Table](expr TypedTableExpr[T, WrappedPrimitive[string]]) TypedTableExpr[T, WrappedPrimitive[string]] {
+	return stringFunction[T, WrappedPrimitive[string]]("UPPER", expr)
+}
+
+// Trim TRIM(expr)
+func Trim[T Table](expr TypedTableExpr[T, WrappedPrimitive[string]]) TypedTableExpr[T, WrappedPrimitive[string]] {
+	return stringFunction[T, WrappedPrimitive[string]]("TRIM", expr)
+}
+
+// Length CHAR_LENGTH(expr)(number of characters, not bytes)
+func Length[T Table](expr TypedTableExpr[T, WrappedPrimitive[string]]) TypedTableExpr[T, WrappedPrimitive[int64]] {
+	return stringFunction[T, WrappedPrimitive[int64]]("CHAR_LENGTH", expr)
+}
+
+// Substring SUBSTRING(expr, pos, length)(pos is 1-based)
+func Substring[T Table](expr TypedTableExpr[T, WrappedPrimitive[string]], pos int64, length int64) TypedTableExpr[T, WrappedPrimitive[string]] {
+	return stringFunction[T, WrappedPrimitive[string]]("SUBSTRING", expr, Wrap(pos), Wrap(length))
+}
+
+// Replace REPLACE(expr, from, to)
+func Replace[T Table](expr TypedTableExpr[T, WrappedPrimitive[string]], from string, to string) TypedTableExpr[T, WrappedPrimitive[string]] {
+	return stringFunction[T, WrappedPrimitive[string]]("REPLACE", expr, Wrap(from), Wrap(to))
+}
+
+func stringFunction[T Table, S ExprType](funcName string, expr TypedTableExpr[T, WrappedPrimitive[string]], literals ...ExprType) TypedTableExpr[T, S] {
+	if expr == nil {
+		return &ExprStruct[T, S]{
+			errs: []error{fmt.Errorf("%s expr is nil", strings.ToLower(funcName))},
+		}
+	}
+
+	query, args, errs := expr.Expr()
+	if len(errs) != 0 {
+		return &ExprStruct[T, S]{
+			errs: errs,
+		}
+	}
+
+	for range literals {
+		query += ", ?"
+	}
+
+	return &ExprStruct[T, S]{
+		query: fmt.Sprintf("%s(%s)", funcName, query),
+		args:  append(args, literals...),
+	}
+}
@@ -0,0 +1,207 @@
+package genorm_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mazrean/genorm"
+	"github.com/mazrean/genorm/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcat(t *testing.T) {
+	t.Parallel()
+
+	type expr struct {
+		isNil bool
+		query string
+		args  []genorm.ExprType
+		errs  []error
+	}
+
+	tests := []struct {
+		description   string
+		exprs         []expr
+		expectedQuery string
+		expectedArgs  []genorm.ExprType
+		isError       bool
+	}{
+		{
+			description:   "single",
+			exprs:         []expr{{query: "hoge.huga"}},
+			expectedQuery: "CONCAT(hoge.huga)",
+			expectedArgs:  []genorm.ExprType{},
+		},
+		{
+			description: "multiple with args",
+			exprs: []expr{
+				{query: "hoge.huga"},
+				{query: "?", args: []genorm.ExprType{genorm.Wrap(" ")}},
+				{query: "hoge.piyo"},
+			},
+			expectedQuery: "CONCAT(hoge.huga, ?, hoge.piyo)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(" ")},
+		},
+		{
+			description: "empty",
+			isError:     true,
+		},
+		{
+			description: "nil expr",
+			exprs:       []expr{{query: "hoge.huga"}, {isNil: true}},
+			isError:     true,
+		},
+		{
+			description: "expr error",
+			exprs:       []expr{{query: "hoge.huga", errs: []error{errors.New("expr error")}}},
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			exprs := make([]genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]], 0, len(test.exprs))
+			for _, expr := range test.exprs {
+				if expr.isNil {
+					exprs = append(exprs, nil)
+					continue
+				}
+
+				mockExpr := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[string]](ctrl)
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return(expr.query, expr.args, expr.errs).
+					AnyTimes()
+
+				exprs = append(exprs, mockExpr)
+			}
+
+			query, args, errs := genorm.Concat(exprs...).Expr()
+			if test.isError {
+				assert.NotEmpty(t, errs)
+				return
+			}
+
+			if !assert.Nil(t, errs) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
+
+func TestStringFunctions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description   string
+		function      func(genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]]) genorm.Expr
+		exprIsNil     bool
+		exprErrs      []error
+		expectedQuery string
+		expectedArgs  []genorm.ExprType
+		isError       bool
+	}{
+		{
+			description: "lower",
+			function: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]]) genorm.Expr {
+				return genorm.Lower(expr)
+			},
+			expectedQuery: "LOWER((hoge.huga + ?))",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap("a")},
+		},
+		{
+			description: "upper",
+			function: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]]) genorm.Expr {
+				return genorm.Upper(expr)
+			},
+			expectedQuery: "UPPER((hoge.huga + ?))",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap("a")},
+		},
+		{
+			description: "trim",
+			function: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]]) genorm.Expr {
+				return genorm.Trim(expr)
+			},
+			expectedQuery: "TRIM((hoge.huga + ?))",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap("a")},
+		},
+		{
+			description: "length",
+			function: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]]) genorm.Expr {
+				var res genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]] = genorm.Length(expr)
+				return res
+			},
+			expectedQuery: "CHAR_LENGTH((hoge.huga + ?))",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap("a")},
+		},
+		{
+			description: "substring",
+			function: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]]) genorm.Expr {
+				return genorm.Substring(expr, 2, 3)
+			},
+			expectedQuery: "SUBSTRING((hoge.huga + ?), ?, ?)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap("a"), genorm.Wrap(int64(2)), genorm.Wrap(int64(3))},
+		},
+		{
+			description: "replace",
+			function: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]]) genorm.Expr {
+				return genorm.Replace(expr, "from", "to")
+			},
+			expectedQuery: "REPLACE((hoge.huga + ?), ?, ?)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap("a"), genorm.Wrap("from"), genorm.Wrap("to")},
+		},
+		{
+			description: "nil expr",
+			function: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]]) genorm.Expr {
+				return genorm.Lower(expr)
+			},
+			exprIsNil: true,
+			isError:   true,
+		},
+		{
+			description: "expr error",
+			function: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]]) genorm.Expr {
+				return genorm.Substring(expr, 1, 1)
+			},
+			exprErrs: []error{errors.New("expr error")},
+			isError:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[string]]
+			if !test.exprIsNil {
+				mockExpr := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[string]](ctrl)
+				expr = mockExpr
+
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return("(hoge.huga + ?)", []genorm.ExprType{genorm.Wrap("a")}, test.exprErrs)
+			}
+
+			query, args, errs := test.function(expr).Expr()
+			if test.isError {
+				assert.NotEmpty(t, errs)
+				return
+			}
+
+			if !assert.Nil(t, errs) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
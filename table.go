@@ -24,3 +24,41 @@ type JoinedTable interface {
 	BaseTables() []BasicTable
 	AddError(error)
 }
+
+type BasicTablePointer[T any] interface {
+	BasicTable
+	*T
+}
+
+// DialectValidator table which is supported only in some dialects(e.g. FULL OUTER JOIN)
+type DialectValidator interface {
+	ValidateDialect(Dialect) error
+}
+
+// OuterJoinedTable joined table whose base tables can be NULL by outer joins
+type OuterJoinedTable interface {
+	NullableTables() []BasicTable
+}
+
+// NullTableSetter joined table which records the base tables NULL by outer joins in the row scanned by Select
+type NullTableSetter interface {
+	SetNull(table BasicTable)
+}
+
+// LateralTable table which can be joined with LATERAL(e.g. DerivedTable)
+type LateralTable interface {
+	Table
+	// LateralExpr LATERAL (SELECT ...) AS alias_name, whose Outer columns reference the columns of outer
+	LateralExpr(outer Table) (string, []ExprType, []error)
+}
+
+// IndexHintTable joined table which renders the index hints right after the references of its base tables
+type IndexHintTable interface {
+	IndexHintExpr(hints *IndexHints) (string, []ExprType, []error)
+}
+
+// DetachableTable joined table from which a base table can be detached(DELETE ... USING and UPDATE ... FROM in PostgreSQL)
+type DetachableTable interface {
+	// Detach the table joined with target and the join condition(nil for CROSS JOIN)
+	Detach(target BasicTable) (Table, Expr, error)
+}
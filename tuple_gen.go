@@ -0,0 +1,2223 @@
+// Code generated by tuplegen. DO NOT EDIT.
+
+package genorm
+
+type Tuple2Struct[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+] struct {
+	value1 T1
+	value2 T2
+	expr1  TypedTableExpr[S, T1]
+	expr2  TypedTableExpr[S, T2]
+}
+
+func Tuple2[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+](expr1 TypedTableExpr[S, T1], expr2 TypedTableExpr[S, T2]) *Tuple2Struct[S, T1, U1, T2, U2] {
+	return &Tuple2Struct[S, T1, U1, T2, U2]{
+		expr1: expr1,
+		expr2: expr2,
+	}
+}
+
+func (t *Tuple2Struct[_, _, _, _, _]) Exprs() []Expr {
+	return []Expr{t.expr1, t.expr2}
+}
+
+func (t *Tuple2Struct[_, _, U1, _, U2]) Columns() []ColumnFieldExprType {
+	return []ColumnFieldExprType{
+		U1(&t.value1),
+		U2(&t.value2),
+	}
+}
+
+func (t *Tuple2Struct[_, T1, _, T2, _]) Values() (T1, T2) {
+	return t.value1, t.value2
+}
+
+// DerivedTuple2Struct typed columns of the derived table of Tuple2
+type DerivedTuple2Struct[
+	D Table,
+	T1 ExprType,
+	T2 ExprType,
+] struct {
+	Value1     TypedTableColumns[D, T1]
+	Value1Expr TypedTableExpr[D, T1]
+	Value2     TypedTableColumns[D, T2]
+	Value2Expr TypedTableExpr[D, T2]
+}
+
+// DerivedTuple2 typed columns of the derived table of Tuple2(alias_name.value1, alias_name.value2, ...)
+func DerivedTuple2[
+	A Alias,
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+](_ *DerivedTable[A, *Tuple2Struct[S, T1, U1, T2, U2], Tuple2Struct[S, T1, U1, T2, U2]]) *DerivedTuple2Struct[*DerivedTable[A, *Tuple2Struct[S, T1, U1, T2, U2], Tuple2Struct[S, T1, U1, T2, U2]], T1, T2] {
+	return &DerivedTuple2Struct[*DerivedTable[A, *Tuple2Struct[S, T1, U1, T2, U2], Tuple2Struct[S, T1, U1, T2, U2]], T1, T2]{
+		Value1:     newDerivedTypedColumn[T1, A, *Tuple2Struct[S, T1, U1, T2, U2]](0),
+		Value1Expr: newDerivedTypedColumn[T1, A, *Tuple2Struct[S, T1, U1, T2, U2]](0),
+		Value2:     newDerivedTypedColumn[T2, A, *Tuple2Struct[S, T1, U1, T2, U2]](1),
+		Value2Expr: newDerivedTypedColumn[T2, A, *Tuple2Struct[S, T1, U1, T2, U2]](1),
+	}
+}
+
+type Tuple3Struct[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+] struct {
+	value1 T1
+	value2 T2
+	value3 T3
+	expr1  TypedTableExpr[S, T1]
+	expr2  TypedTableExpr[S, T2]
+	expr3  TypedTableExpr[S, T3]
+}
+
+func Tuple3[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+](expr1 TypedTableExpr[S, T1], expr2 TypedTableExpr[S, T2], expr3 TypedTableExpr[S, T3]) *Tuple3Struct[S, T1, U1, T2, U2, T3, U3] {
+	return &Tuple3Struct[S, T1, U1, T2, U2, T3, U3]{
+		expr1: expr1,
+		expr2: expr2,
+		expr3: expr3,
+	}
+}
+
+func (t *Tuple3Struct[_, _, _, _, _, _, _]) Exprs() []Expr {
+	return []Expr{t.expr1, t.expr2, t.expr3}
+}
+
+func (t *Tuple3Struct[_, _, U1, _, U2, _, U3]) Columns() []ColumnFieldExprType {
+	return []ColumnFieldExprType{
+		U1(&t.value1),
+		U2(&t.value2),
+		U3(&t.value3),
+	}
+}
+
+func (t *Tuple3Struct[_, T1, _, T2, _, T3, _]) Values() (T1, T2, T3) {
+	return t.value1, t.value2, t.value3
+}
+
+// DerivedTuple3Struct typed columns of the derived table of Tuple3
+type DerivedTuple3Struct[
+	D Table,
+	T1 ExprType,
+	T2 ExprType,
+	T3 ExprType,
+] struct {
+	Value1     TypedTableColumns[D, T1]
+	Value1Expr TypedTableExpr[D, T1]
+	Value2     TypedTableColumns[D, T2]
+	Value2Expr TypedTableExpr[D, T2]
+	Value3     TypedTableColumns[D, T3]
+	Value3Expr TypedTableExpr[D, T3]
+}
+
+// DerivedTuple3 typed columns of the derived table of Tuple3(alias_name.value1, alias_name.value2, ...)
+func DerivedTuple3[
+	A Alias,
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+](_ *DerivedTable[A, *Tuple3Struct[S, T1, U1, T2, U2, T3, U3], Tuple3Struct[S, T1, U1, T2, U2, T3, U3]]) *DerivedTuple3Struct[*DerivedTable[A, *Tuple3Struct[S, T1, U1, T2, U2, T3, U3], Tuple3Struct[S, T1, U1, T2, U2, T3, U3]], T1, T2, T3] {
+	return &DerivedTuple3Struct[*DerivedTable[A, *Tuple3Struct[S, T1, U1, T2, U2, T3, U3], Tuple3Struct[S, T1, U1, T2, U2, T3, U3]], T1, T2, T3]{
+		Value1:     newDerivedTypedColumn[T1, A, *Tuple3Struct[S, T1, U1, T2, U2, T3, U3]](0),
+		Value1Expr: newDerivedTypedColumn[T1, A, *Tuple3Struct[S, T1, U1, T2, U2, T3, U3]](0),
+		Value2:     newDerivedTypedColumn[T2, A, *Tuple3Struct[S, T1, U1, T2, U2, T3, U3]](1),
+		Value2Expr: newDerivedTypedColumn[T2, A, *Tuple3Struct[S, T1, U1, T2, U2, T3, U3]](1),
+		Value3:     newDerivedTypedColumn[T3, A, *Tuple3Struct[S, T1, U1, T2, U2, T3, U3]](2),
+		Value3Expr: newDerivedTypedColumn[T3, A, *Tuple3Struct[S, T1, U1, T2, U2, T3, U3]](2),
+	}
+}
+
+type Tuple4Struct[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+] struct {
+	value1 T1
+	value2 T2
+	value3 T3
+	value4 T4
+	expr1  TypedTableExpr[S, T1]
+	expr2  TypedTableExpr[S, T2]
+	expr3  TypedTableExpr[S, T3]
+	expr4  TypedTableExpr[S, T4]
+}
+
+func Tuple4[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+](expr1 TypedTableExpr[S, T1], expr2 TypedTableExpr[S, T2], expr3 TypedTableExpr[S, T3], expr4 TypedTableExpr[S, T4]) *Tuple4Struct[S, T1, U1, T2, U2, T3, U3, T4, U4] {
+	return &Tuple4Struct[S, T1, U1, T2, U2, T3, U3, T4, U4]{
+		expr1: expr1,
+		expr2: expr2,
+		expr3: expr3,
+		expr4: expr4,
+	}
+}
+
+func (t *Tuple4Struct[_, _, _, _, _, _, _, _, _]) Exprs() []Expr {
+	return []Expr{t.expr1, t.expr2, t.expr3, t.expr4}
+}
+
+func (t *Tuple4Struct[_, _, U1, _, U2, _, U3, _, U4]) Columns() []ColumnFieldExprType {
+	return []ColumnFieldExprType{
+		U1(&t.value1),
+		U2(&t.value2),
+		U3(&t.value3),
+		U4(&t.value4),
+	}
+}
+
+func (t *Tuple4Struct[_, T1, _, T2, _, T3, _, T4, _]) Values() (T1, T2, T3, T4) {
+	return t.value1, t.value2, t.value3, t.value4
+}
+
+// DerivedTuple4Struct typed columns of the derived table of Tuple4
+type DerivedTuple4Struct[
+	D Table,
+	T1 ExprType,
+	T2 ExprType,
+	T3 ExprType,
+	T4 ExprType,
+] struct {
+	Value1     TypedTableColumns[D, T1]
+	Value1Expr TypedTableExpr[D, T1]
+	Value2     TypedTableColumns[D, T2]
+	Value2Expr TypedTableExpr[D, T2]
+	Value3     TypedTableColumns[D, T3]
+	Value3Expr TypedTableExpr[D, T3]
+	Value4     TypedTableColumns[D, T4]
+	Value4Expr TypedTableExpr[D, T4]
+}
+
+// DerivedTuple4 typed columns of the derived table of Tuple4(alias_name.value1, alias_name.value2, ...)
+func DerivedTuple4[
+	A Alias,
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+](_ *DerivedTable[A, *Tuple4Struct[S, T1, U1, T2, U2, T3, U3, T4, U4], Tuple4Struct[S, T1, U1, T2, U2, T3, U3, T4, U4]]) *DerivedTuple4Struct[*DerivedTable[A, *Tuple4Struct[S, T1, U1, T2, U2, T3, U3, T4, U4], Tuple4Struct[S, T1, U1, T2, U2, T3, U3, T4, U4]], T1, T2, T3, T4] {
+	return &DerivedTuple4Struct[*DerivedTable[A, *Tuple4Struct[S, T1, U1, T2, U2, T3, U3, T4, U4], Tuple4Struct[S, T1, U1, T2, U2, T3, U3, T4, U4]], T1, T2, T3, T4]{
+		Value1:     newDerivedTypedColumn[T1, A, *Tuple4Struct[S, T1, U1, T2, U2, T3, U3, T4, U4]](0),
+		Value1Expr: newDerivedTypedColumn[T1, A, *Tuple4Struct[S, T1, U1, T2, U2, T3, U3, T4, U4]](0),
+		Value2:     newDerivedTypedColumn[T2, A, *Tuple4Struct[S, T1, U1, T2, U2, T3, U3, T4, U4]](1),
+		Value2Expr: newDerivedTypedColumn[T2, A, *Tuple4Struct[S, T1, U1, T2, U2, T3, U3, T4, U4]](1),
+		Value3:     newDerivedTypedColumn[T3, A, *Tuple4Struct[S, T1, U1, T2, U2, T3, U3, T4, U4]](2),
+		Value3Expr: newDerivedTypedColumn[T3, A, *Tuple4Struct[S, T1, U1, T2, U2, T3, U3, T4, U4]](2),
+		Value4:     newDerivedTypedColumn[T4, A, *Tuple4Struct[S, T1, U1, T2, U2, T3, U3, T4, U4]](3),
+		Value4Expr: newDerivedTypedColumn[T4, A, *Tuple4Struct[S, T1, U1, T2, U2, T3, U3, T4, U4]](3),
+	}
+}
+
+type Tuple5Struct[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+] struct {
+	value1 T1
+	value2 T2
+	value3 T3
+	value4 T4
+	value5 T5
+	expr1  TypedTableExpr[S, T1]
+	expr2  TypedTableExpr[S, T2]
+	expr3  TypedTableExpr[S, T3]
+	expr4  TypedTableExpr[S, T4]
+	expr5  TypedTableExpr[S, T5]
+}
+
+func Tuple5[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+](expr1 TypedTableExpr[S, T1], expr2 TypedTableExpr[S, T2], expr3 TypedTableExpr[S, T3], expr4 TypedTableExpr[S, T4], expr5 TypedTableExpr[S, T5]) *Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5] {
+	return &Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5]{
+		expr1: expr1,
+		expr2: expr2,
+		expr3: expr3,
+		expr4: expr4,
+		expr5: expr5,
+	}
+}
+
+func (t *Tuple5Struct[_, _, _, _, _, _, _, _, _, _, _]) Exprs() []Expr {
+	return []Expr{t.expr1, t.expr2, t.expr3, t.expr4, t.expr5}
+}
+
+func (t *Tuple5Struct[_, _, U1, _, U2, _, U3, _, U4, _, U5]) Columns() []ColumnFieldExprType {
+	return []ColumnFieldExprType{
+		U1(&t.value1),
+		U2(&t.value2),
+		U3(&t.value3),
+		U4(&t.value4),
+		U5(&t.value5),
+	}
+}
+
+func (t *Tuple5Struct[_, T1, _, T2, _, T3, _, T4, _, T5, _]) Values() (T1, T2, T3, T4, T5) {
+	return t.value1, t.value2, t.value3, t.value4, t.value5
+}
+
+// DerivedTuple5Struct typed columns of the derived table of Tuple5
+type DerivedTuple5Struct[
+	D Table,
+	T1 ExprType,
+	T2 ExprType,
+	T3 ExprType,
+	T4 ExprType,
+	T5 ExprType,
+] struct {
+	Value1     TypedTableColumns[D, T1]
+	Value1Expr TypedTableExpr[D, T1]
+	Value2     TypedTableColumns[D, T2]
+	Value2Expr TypedTableExpr[D, T2]
+	Value3     TypedTableColumns[D, T3]
+	Value3Expr TypedTableExpr[D, T3]
+	Value4     TypedTableColumns[D, T4]
+	Value4Expr TypedTableExpr[D, T4]
+	Value5     TypedTableColumns[D, T5]
+	Value5Expr TypedTableExpr[D, T5]
+}
+
+// DerivedTuple5 typed columns of the derived table of Tuple5(alias_name.value1, alias_name.value2, ...)
+func DerivedTuple5[
+	A Alias,
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+](_ *DerivedTable[A, *Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5], Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5]]) *DerivedTuple5Struct[*DerivedTable[A, *Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5], Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5]], T1, T2, T3, T4, T5] {
+	return &DerivedTuple5Struct[*DerivedTable[A, *Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5], Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5]], T1, T2, T3, T4, T5]{
+		Value1:     newDerivedTypedColumn[T1, A, *Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5]](0),
+		Value1Expr: newDerivedTypedColumn[T1, A, *Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5]](0),
+		Value2:     newDerivedTypedColumn[T2, A, *Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5]](1),
+		Value2Expr: newDerivedTypedColumn[T2, A, *Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5]](1),
+		Value3:     newDerivedTypedColumn[T3, A, *Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5]](2),
+		Value3Expr: newDerivedTypedColumn[T3, A, *Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5]](2),
+		Value4:     newDerivedTypedColumn[T4, A, *Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5]](3),
+		Value4Expr: newDerivedTypedColumn[T4, A, *Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5]](3),
+		Value5:     newDerivedTypedColumn[T5, A, *Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5]](4),
+		Value5Expr: newDerivedTypedColumn[T5, A, *Tuple5Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5]](4),
+	}
+}
+
+type Tuple6Struct[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+] struct {
+	value1 T1
+	value2 T2
+	value3 T3
+	value4 T4
+	value5 T5
+	value6 T6
+	expr1  TypedTableExpr[S, T1]
+	expr2  TypedTableExpr[S, T2]
+	expr3  TypedTableExpr[S, T3]
+	expr4  TypedTableExpr[S, T4]
+	expr5  TypedTableExpr[S, T5]
+	expr6  TypedTableExpr[S, T6]
+}
+
+func Tuple6[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+](expr1 TypedTableExpr[S, T1], expr2 TypedTableExpr[S, T2], expr3 TypedTableExpr[S, T3], expr4 TypedTableExpr[S, T4], expr5 TypedTableExpr[S, T5], expr6 TypedTableExpr[S, T6]) *Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6] {
+	return &Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6]{
+		expr1: expr1,
+		expr2: expr2,
+		expr3: expr3,
+		expr4: expr4,
+		expr5: expr5,
+		expr6: expr6,
+	}
+}
+
+func (t *Tuple6Struct[_, _, _, _, _, _, _, _, _, _, _, _, _]) Exprs() []Expr {
+	return []Expr{t.expr1, t.expr2, t.expr3, t.expr4, t.expr5, t.expr6}
+}
+
+func (t *Tuple6Struct[_, _, U1, _, U2, _, U3, _, U4, _, U5, _, U6]) Columns() []ColumnFieldExprType {
+	return []ColumnFieldExprType{
+		U1(&t.value1),
+		U2(&t.value2),
+		U3(&t.value3),
+		U4(&t.value4),
+		U5(&t.value5),
+		U6(&t.value6),
+	}
+}
+
+func (t *Tuple6Struct[_, T1, _, T2, _, T3, _, T4, _, T5, _, T6, _]) Values() (T1, T2, T3, T4, T5, T6) {
+	return t.value1, t.value2, t.value3, t.value4, t.value5, t.value6
+}
+
+// DerivedTuple6Struct typed columns of the derived table of Tuple6
+type DerivedTuple6Struct[
+	D Table,
+	T1 ExprType,
+	T2 ExprType,
+	T3 ExprType,
+	T4 ExprType,
+	T5 ExprType,
+	T6 ExprType,
+] struct {
+	Value1     TypedTableColumns[D, T1]
+	Value1Expr TypedTableExpr[D, T1]
+	Value2     TypedTableColumns[D, T2]
+	Value2Expr TypedTableExpr[D, T2]
+	Value3     TypedTableColumns[D, T3]
+	Value3Expr TypedTableExpr[D, T3]
+	Value4     TypedTableColumns[D, T4]
+	Value4Expr TypedTableExpr[D, T4]
+	Value5     TypedTableColumns[D, T5]
+	Value5Expr TypedTableExpr[D, T5]
+	Value6     TypedTableColumns[D, T6]
+	Value6Expr TypedTableExpr[D, T6]
+}
+
+// DerivedTuple6 typed columns of the derived table of Tuple6(alias_name.value1, alias_name.value2, ...)
+func DerivedTuple6[
+	A Alias,
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+](_ *DerivedTable[A, *Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6], Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6]]) *DerivedTuple6Struct[*DerivedTable[A, *Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6], Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6]], T1, T2, T3, T4, T5, T6] {
+	return &DerivedTuple6Struct[*DerivedTable[A, *Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6], Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6]], T1, T2, T3, T4, T5, T6]{
+		Value1:     newDerivedTypedColumn[T1, A, *Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6]](0),
+		Value1Expr: newDerivedTypedColumn[T1, A, *Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6]](0),
+		Value2:     newDerivedTypedColumn[T2, A, *Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6]](1),
+		Value2Expr: newDerivedTypedColumn[T2, A, *Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6]](1),
+		Value3:     newDerivedTypedColumn[T3, A, *Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6]](2),
+		Value3Expr: newDerivedTypedColumn[T3, A, *Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6]](2),
+		Value4:     newDerivedTypedColumn[T4, A, *Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6]](3),
+		Value4Expr: newDerivedTypedColumn[T4, A, *Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6]](3),
+		Value5:     newDerivedTypedColumn[T5, A, *Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6]](4),
+		Value5Expr: newDerivedTypedColumn[T5, A, *Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6]](4),
+		Value6:     newDerivedTypedColumn[T6, A, *Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6]](5),
+		Value6Expr: newDerivedTypedColumn[T6, A, *Tuple6Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6]](5),
+	}
+}
+
+type Tuple7Struct[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+] struct {
+	value1 T1
+	value2 T2
+	value3 T3
+	value4 T4
+	value5 T5
+	value6 T6
+	value7 T7
+	expr1  TypedTableExpr[S, T1]
+	expr2  TypedTableExpr[S, T2]
+	expr3  TypedTableExpr[S, T3]
+	expr4  TypedTableExpr[S, T4]
+	expr5  TypedTableExpr[S, T5]
+	expr6  TypedTableExpr[S, T6]
+	expr7  TypedTableExpr[S, T7]
+}
+
+func Tuple7[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+](expr1 TypedTableExpr[S, T1], expr2 TypedTableExpr[S, T2], expr3 TypedTableExpr[S, T3], expr4 TypedTableExpr[S, T4], expr5 TypedTableExpr[S, T5], expr6 TypedTableExpr[S, T6], expr7 TypedTableExpr[S, T7]) *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7] {
+	return &Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]{
+		expr1: expr1,
+		expr2: expr2,
+		expr3: expr3,
+		expr4: expr4,
+		expr5: expr5,
+		expr6: expr6,
+		expr7: expr7,
+	}
+}
+
+func (t *Tuple7Struct[_, _, _, _, _, _, _, _, _, _, _, _, _, _, _]) Exprs() []Expr {
+	return []Expr{t.expr1, t.expr2, t.expr3, t.expr4, t.expr5, t.expr6, t.expr7}
+}
+
+func (t *Tuple7Struct[_, _, U1, _, U2, _, U3, _, U4, _, U5, _, U6, _, U7]) Columns() []ColumnFieldExprType {
+	return []ColumnFieldExprType{
+		U1(&t.value1),
+		U2(&t.value2),
+		U3(&t.value3),
+		U4(&t.value4),
+		U5(&t.value5),
+		U6(&t.value6),
+		U7(&t.value7),
+	}
+}
+
+func (t *Tuple7Struct[_, T1, _, T2, _, T3, _, T4, _, T5, _, T6, _, T7, _]) Values() (T1, T2, T3, T4, T5, T6, T7) {
+	return t.value1, t.value2, t.value3, t.value4, t.value5, t.value6, t.value7
+}
+
+// DerivedTuple7Struct typed columns of the derived table of Tuple7
+type DerivedTuple7Struct[
+	D Table,
+	T1 ExprType,
+	T2 ExprType,
+	T3 ExprType,
+	T4 ExprType,
+	T5 ExprType,
+	T6 ExprType,
+	T7 ExprType,
+] struct {
+	Value1     TypedTableColumns[D, T1]
+	Value1Expr TypedTableExpr[D, T1]
+	Value2     TypedTableColumns[D, T2]
+	Value2Expr TypedTableExpr[D, T2]
+	Value3     TypedTableColumns[D, T3]
+	Value3Expr TypedTableExpr[D, T3]
+	Value4     TypedTableColumns[D, T4]
+	Value4Expr TypedTableExpr[D, T4]
+	Value5     TypedTableColumns[D, T5]
+	Value5Expr TypedTableExpr[D, T5]
+	Value6     TypedTableColumns[D, T6]
+	Value6Expr TypedTableExpr[D, T6]
+	Value7     TypedTableColumns[D, T7]
+	Value7Expr TypedTableExpr[D, T7]
+}
+
+// DerivedTuple7 typed columns of the derived table of Tuple7(alias_name.value1, alias_name.value2, ...)
+func DerivedTuple7[
+	A Alias,
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+](_ *DerivedTable[A, *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7], Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]]) *DerivedTuple7Struct[*DerivedTable[A, *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7], Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]], T1, T2, T3, T4, T5, T6, T7] {
+	return &DerivedTuple7Struct[*DerivedTable[A, *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7], Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]], T1, T2, T3, T4, T5, T6, T7]{
+		Value1:     newDerivedTypedColumn[T1, A, *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]](0),
+		Value1Expr: newDerivedTypedColumn[T1, A, *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]](0),
+		Value2:     newDerivedTypedColumn[T2, A, *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]](1),
+		Value2Expr: newDerivedTypedColumn[T2, A, *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]](1),
+		Value3:     newDerivedTypedColumn[T3, A, *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]](2),
+		Value3Expr: newDerivedTypedColumn[T3, A, *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]](2),
+		Value4:     newDerivedTypedColumn[T4, A, *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]](3),
+		Value4Expr: newDerivedTypedColumn[T4, A, *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]](3),
+		Value5:     newDerivedTypedColumn[T5, A, *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]](4),
+		Value5Expr: newDerivedTypedColumn[T5, A, *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]](4),
+		Value6:     newDerivedTypedColumn[T6, A, *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]](5),
+		Value6Expr: newDerivedTypedColumn[T6, A, *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]](5),
+		Value7:     newDerivedTypedColumn[T7, A, *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]](6),
+		Value7Expr: newDerivedTypedColumn[T7, A, *Tuple7Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7]](6),
+	}
+}
+
+type Tuple8Struct[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+] struct {
+	value1 T1
+	value2 T2
+	value3 T3
+	value4 T4
+	value5 T5
+	value6 T6
+	value7 T7
+	value8 T8
+	expr1  TypedTableExpr[S, T1]
+	expr2  TypedTableExpr[S, T2]
+	expr3  TypedTableExpr[S, T3]
+	expr4  TypedTableExpr[S, T4]
+	expr5  TypedTableExpr[S, T5]
+	expr6  TypedTableExpr[S, T6]
+	expr7  TypedTableExpr[S, T7]
+	expr8  TypedTableExpr[S, T8]
+}
+
+func Tuple8[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+](expr1 TypedTableExpr[S, T1], expr2 TypedTableExpr[S, T2], expr3 TypedTableExpr[S, T3], expr4 TypedTableExpr[S, T4], expr5 TypedTableExpr[S, T5], expr6 TypedTableExpr[S, T6], expr7 TypedTableExpr[S, T7], expr8 TypedTableExpr[S, T8]) *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8] {
+	return &Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]{
+		expr1: expr1,
+		expr2: expr2,
+		expr3: expr3,
+		expr4: expr4,
+		expr5: expr5,
+		expr6: expr6,
+		expr7: expr7,
+		expr8: expr8,
+	}
+}
+
+func (t *Tuple8Struct[_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _]) Exprs() []Expr {
+	return []Expr{t.expr1, t.expr2, t.expr3, t.expr4, t.expr5, t.expr6, t.expr7, t.expr8}
+}
+
+func (t *Tuple8Struct[_, _, U1, _, U2, _, U3, _, U4, _, U5, _, U6, _, U7, _, U8]) Columns() []ColumnFieldExprType {
+	return []ColumnFieldExprType{
+		U1(&t.value1),
+		U2(&t.value2),
+		U3(&t.value3),
+		U4(&t.value4),
+		U5(&t.value5),
+		U6(&t.value6),
+		U7(&t.value7),
+		U8(&t.value8),
+	}
+}
+
+func (t *Tuple8Struct[_, T1, _, T2, _, T3, _, T4, _, T5, _, T6, _, T7, _, T8, _]) Values() (T1, T2, T3, T4, T5, T6, T7, T8) {
+	return t.value1, t.value2, t.value3, t.value4, t.value5, t.value6, t.value7, t.value8
+}
+
+// DerivedTuple8Struct typed columns of the derived table of Tuple8
+type DerivedTuple8Struct[
+	D Table,
+	T1 ExprType,
+	T2 ExprType,
+	T3 ExprType,
+	T4 ExprType,
+	T5 ExprType,
+	T6 ExprType,
+	T7 ExprType,
+	T8 ExprType,
+] struct {
+	Value1     TypedTableColumns[D, T1]
+	Value1Expr TypedTableExpr[D, T1]
+	Value2     TypedTableColumns[D, T2]
+	Value2Expr TypedTableExpr[D, T2]
+	Value3     TypedTableColumns[D, T3]
+	Value3Expr TypedTableExpr[D, T3]
+	Value4     TypedTableColumns[D, T4]
+	Value4Expr TypedTableExpr[D, T4]
+	Value5     TypedTableColumns[D, T5]
+	Value5Expr TypedTableExpr[D, T5]
+	Value6     TypedTableColumns[D, T6]
+	Value6Expr TypedTableExpr[D, T6]
+	Value7     TypedTableColumns[D, T7]
+	Value7Expr TypedTableExpr[D, T7]
+	Value8     TypedTableColumns[D, T8]
+	Value8Expr TypedTableExpr[D, T8]
+}
+
+// DerivedTuple8 typed columns of the derived table of Tuple8(alias_name.value1, alias_name.value2, ...)
+func DerivedTuple8[
+	A Alias,
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+](_ *DerivedTable[A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8], Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]]) *DerivedTuple8Struct[*DerivedTable[A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8], Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]], T1, T2, T3, T4, T5, T6, T7, T8] {
+	return &DerivedTuple8Struct[*DerivedTable[A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8], Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]], T1, T2, T3, T4, T5, T6, T7, T8]{
+		Value1:     newDerivedTypedColumn[T1, A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]](0),
+		Value1Expr: newDerivedTypedColumn[T1, A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]](0),
+		Value2:     newDerivedTypedColumn[T2, A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]](1),
+		Value2Expr: newDerivedTypedColumn[T2, A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]](1),
+		Value3:     newDerivedTypedColumn[T3, A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]](2),
+		Value3Expr: newDerivedTypedColumn[T3, A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]](2),
+		Value4:     newDerivedTypedColumn[T4, A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]](3),
+		Value4Expr: newDerivedTypedColumn[T4, A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]](3),
+		Value5:     newDerivedTypedColumn[T5, A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]](4),
+		Value5Expr: newDerivedTypedColumn[T5, A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]](4),
+		Value6:     newDerivedTypedColumn[T6, A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]](5),
+		Value6Expr: newDerivedTypedColumn[T6, A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]](5),
+		Value7:     newDerivedTypedColumn[T7, A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]](6),
+		Value7Expr: newDerivedTypedColumn[T7, A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]](6),
+		Value8:     newDerivedTypedColumn[T8, A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]](7),
+		Value8Expr: newDerivedTypedColumn[T8, A, *Tuple8Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8]](7),
+	}
+}
+
+type Tuple9Struct[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+] struct {
+	value1 T1
+	value2 T2
+	value3 T3
+	value4 T4
+	value5 T5
+	value6 T6
+	value7 T7
+	value8 T8
+	value9 T9
+	expr1  TypedTableExpr[S, T1]
+	expr2  TypedTableExpr[S, T2]
+	expr3  TypedTableExpr[S, T3]
+	expr4  TypedTableExpr[S, T4]
+	expr5  TypedTableExpr[S, T5]
+	expr6  TypedTableExpr[S, T6]
+	expr7  TypedTableExpr[S, T7]
+	expr8  TypedTableExpr[S, T8]
+	expr9  TypedTableExpr[S, T9]
+}
+
+func Tuple9[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+](expr1 TypedTableExpr[S, T1], expr2 TypedTableExpr[S, T2], expr3 TypedTableExpr[S, T3], expr4 TypedTableExpr[S, T4], expr5 TypedTableExpr[S, T5], expr6 TypedTableExpr[S, T6], expr7 TypedTableExpr[S, T7], expr8 TypedTableExpr[S, T8], expr9 TypedTableExpr[S, T9]) *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9] {
+	return &Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]{
+		expr1: expr1,
+		expr2: expr2,
+		expr3: expr3,
+		expr4: expr4,
+		expr5: expr5,
+		expr6: expr6,
+		expr7: expr7,
+		expr8: expr8,
+		expr9: expr9,
+	}
+}
+
+func (t *Tuple9Struct[_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _]) Exprs() []Expr {
+	return []Expr{t.expr1, t.expr2, t.expr3, t.expr4, t.expr5, t.expr6, t.expr7, t.expr8, t.expr9}
+}
+
+func (t *Tuple9Struct[_, _, U1, _, U2, _, U3, _, U4, _, U5, _, U6, _, U7, _, U8, _, U9]) Columns() []ColumnFieldExprType {
+	return []ColumnFieldExprType{
+		U1(&t.value1),
+		U2(&t.value2),
+		U3(&t.value3),
+		U4(&t.value4),
+		U5(&t.value5),
+		U6(&t.value6),
+		U7(&t.value7),
+		U8(&t.value8),
+		U9(&t.value9),
+	}
+}
+
+func (t *Tuple9Struct[_, T1, _, T2, _, T3, _, T4, _, T5, _, T6, _, T7, _, T8, _, T9, _]) Values() (T1, T2, T3, T4, T5, T6, T7, T8, T9) {
+	return t.value1, t.value2, t.value3, t.value4, t.value5, t.value6, t.value7, t.value8, t.value9
+}
+
+// DerivedTuple9Struct typed columns of the derived table of Tuple9
+type DerivedTuple9Struct[
+	D Table,
+	T1 ExprType,
+	T2 ExprType,
+	T3 ExprType,
+	T4 ExprType,
+	T5 ExprType,
+	T6 ExprType,
+	T7 ExprType,
+	T8 ExprType,
+	T9 ExprType,
+] struct {
+	Value1     TypedTableColumns[D, T1]
+	Value1Expr TypedTableExpr[D, T1]
+	Value2     TypedTableColumns[D, T2]
+	Value2Expr TypedTableExpr[D, T2]
+	Value3     TypedTableColumns[D, T3]
+	Value3Expr TypedTableExpr[D, T3]
+	Value4     TypedTableColumns[D, T4]
+	Value4Expr TypedTableExpr[D, T4]
+	Value5     TypedTableColumns[D, T5]
+	Value5Expr TypedTableExpr[D, T5]
+	Value6     TypedTableColumns[D, T6]
+	Value6Expr TypedTableExpr[D, T6]
+	Value7     TypedTableColumns[D, T7]
+	Value7Expr TypedTableExpr[D, T7]
+	Value8     TypedTableColumns[D, T8]
+	Value8Expr TypedTableExpr[D, T8]
+	Value9     TypedTableColumns[D, T9]
+	Value9Expr TypedTableExpr[D, T9]
+}
+
+// DerivedTuple9 typed columns of the derived table of Tuple9(alias_name.value1, alias_name.value2, ...)
+func DerivedTuple9[
+	A Alias,
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+](_ *DerivedTable[A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9], Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]]) *DerivedTuple9Struct[*DerivedTable[A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9], Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]], T1, T2, T3, T4, T5, T6, T7, T8, T9] {
+	return &DerivedTuple9Struct[*DerivedTable[A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9], Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]], T1, T2, T3, T4, T5, T6, T7, T8, T9]{
+		Value1:     newDerivedTypedColumn[T1, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](0),
+		Value1Expr: newDerivedTypedColumn[T1, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](0),
+		Value2:     newDerivedTypedColumn[T2, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](1),
+		Value2Expr: newDerivedTypedColumn[T2, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](1),
+		Value3:     newDerivedTypedColumn[T3, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](2),
+		Value3Expr: newDerivedTypedColumn[T3, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](2),
+		Value4:     newDerivedTypedColumn[T4, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](3),
+		Value4Expr: newDerivedTypedColumn[T4, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](3),
+		Value5:     newDerivedTypedColumn[T5, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](4),
+		Value5Expr: newDerivedTypedColumn[T5, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](4),
+		Value6:     newDerivedTypedColumn[T6, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](5),
+		Value6Expr: newDerivedTypedColumn[T6, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](5),
+		Value7:     newDerivedTypedColumn[T7, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](6),
+		Value7Expr: newDerivedTypedColumn[T7, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](6),
+		Value8:     newDerivedTypedColumn[T8, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](7),
+		Value8Expr: newDerivedTypedColumn[T8, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](7),
+		Value9:     newDerivedTypedColumn[T9, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](8),
+		Value9Expr: newDerivedTypedColumn[T9, A, *Tuple9Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9]](8),
+	}
+}
+
+type Tuple10Struct[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+] struct {
+	value1  T1
+	value2  T2
+	value3  T3
+	value4  T4
+	value5  T5
+	value6  T6
+	value7  T7
+	value8  T8
+	value9  T9
+	value10 T10
+	expr1   TypedTableExpr[S, T1]
+	expr2   TypedTableExpr[S, T2]
+	expr3   TypedTableExpr[S, T3]
+	expr4   TypedTableExpr[S, T4]
+	expr5   TypedTableExpr[S, T5]
+	expr6   TypedTableExpr[S, T6]
+	expr7   TypedTableExpr[S, T7]
+	expr8   TypedTableExpr[S, T8]
+	expr9   TypedTableExpr[S, T9]
+	expr10  TypedTableExpr[S, T10]
+}
+
+func Tuple10[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+](expr1 TypedTableExpr[S, T1], expr2 TypedTableExpr[S, T2], expr3 TypedTableExpr[S, T3], expr4 TypedTableExpr[S, T4], expr5 TypedTableExpr[S, T5], expr6 TypedTableExpr[S, T6], expr7 TypedTableExpr[S, T7], expr8 TypedTableExpr[S, T8], expr9 TypedTableExpr[S, T9], expr10 TypedTableExpr[S, T10]) *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10] {
+	return &Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]{
+		expr1:  expr1,
+		expr2:  expr2,
+		expr3:  expr3,
+		expr4:  expr4,
+		expr5:  expr5,
+		expr6:  expr6,
+		expr7:  expr7,
+		expr8:  expr8,
+		expr9:  expr9,
+		expr10: expr10,
+	}
+}
+
+func (t *Tuple10Struct[_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _]) Exprs() []Expr {
+	return []Expr{t.expr1, t.expr2, t.expr3, t.expr4, t.expr5, t.expr6, t.expr7, t.expr8, t.expr9, t.expr10}
+}
+
+func (t *Tuple10Struct[_, _, U1, _, U2, _, U3, _, U4, _, U5, _, U6, _, U7, _, U8, _, U9, _, U10]) Columns() []ColumnFieldExprType {
+	return []ColumnFieldExprType{
+		U1(&t.value1),
+		U2(&t.value2),
+		U3(&t.value3),
+		U4(&t.value4),
+		U5(&t.value5),
+		U6(&t.value6),
+		U7(&t.value7),
+		U8(&t.value8),
+		U9(&t.value9),
+		U10(&t.value10),
+	}
+}
+
+func (t *Tuple10Struct[_, T1, _, T2, _, T3, _, T4, _, T5, _, T6, _, T7, _, T8, _, T9, _, T10, _]) Values() (T1, T2, T3, T4, T5, T6, T7, T8, T9, T10) {
+	return t.value1, t.value2, t.value3, t.value4, t.value5, t.value6, t.value7, t.value8, t.value9, t.value10
+}
+
+// DerivedTuple10Struct typed columns of the derived table of Tuple10
+type DerivedTuple10Struct[
+	D Table,
+	T1 ExprType,
+	T2 ExprType,
+	T3 ExprType,
+	T4 ExprType,
+	T5 ExprType,
+	T6 ExprType,
+	T7 ExprType,
+	T8 ExprType,
+	T9 ExprType,
+	T10 ExprType,
+] struct {
+	Value1      TypedTableColumns[D, T1]
+	Value1Expr  TypedTableExpr[D, T1]
+	Value2      TypedTableColumns[D, T2]
+	Value2Expr  TypedTableExpr[D, T2]
+	Value3      TypedTableColumns[D, T3]
+	Value3Expr  TypedTableExpr[D, T3]
+	Value4      TypedTableColumns[D, T4]
+	Value4Expr  TypedTableExpr[D, T4]
+	Value5      TypedTableColumns[D, T5]
+	Value5Expr  TypedTableExpr[D, T5]
+	Value6      TypedTableColumns[D, T6]
+	Value6Expr  TypedTableExpr[D, T6]
+	Value7      TypedTableColumns[D, T7]
+	Value7Expr  TypedTableExpr[D, T7]
+	Value8      TypedTableColumns[D, T8]
+	Value8Expr  TypedTableExpr[D, T8]
+	Value9      TypedTableColumns[D, T9]
+	Value9Expr  TypedTableExpr[D, T9]
+	Value10     TypedTableColumns[D, T10]
+	Value10Expr TypedTableExpr[D, T10]
+}
+
+// DerivedTuple10 typed columns of the derived table of Tuple10(alias_name.value1, alias_name.value2, ...)
+func DerivedTuple10[
+	A Alias,
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+](_ *DerivedTable[A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10], Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]]) *DerivedTuple10Struct[*DerivedTable[A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10], Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]], T1, T2, T3, T4, T5, T6, T7, T8, T9, T10] {
+	return &DerivedTuple10Struct[*DerivedTable[A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10], Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]], T1, T2, T3, T4, T5, T6, T7, T8, T9, T10]{
+		Value1:      newDerivedTypedColumn[T1, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](0),
+		Value1Expr:  newDerivedTypedColumn[T1, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](0),
+		Value2:      newDerivedTypedColumn[T2, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](1),
+		Value2Expr:  newDerivedTypedColumn[T2, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](1),
+		Value3:      newDerivedTypedColumn[T3, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](2),
+		Value3Expr:  newDerivedTypedColumn[T3, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](2),
+		Value4:      newDerivedTypedColumn[T4, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](3),
+		Value4Expr:  newDerivedTypedColumn[T4, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](3),
+		Value5:      newDerivedTypedColumn[T5, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](4),
+		Value5Expr:  newDerivedTypedColumn[T5, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](4),
+		Value6:      newDerivedTypedColumn[T6, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](5),
+		Value6Expr:  newDerivedTypedColumn[T6, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](5),
+		Value7:      newDerivedTypedColumn[T7, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](6),
+		Value7Expr:  newDerivedTypedColumn[T7, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](6),
+		Value8:      newDerivedTypedColumn[T8, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](7),
+		Value8Expr:  newDerivedTypedColumn[T8, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](7),
+		Value9:      newDerivedTypedColumn[T9, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](8),
+		Value9Expr:  newDerivedTypedColumn[T9, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](8),
+		Value10:     newDerivedTypedColumn[T10, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](9),
+		Value10Expr: newDerivedTypedColumn[T10, A, *Tuple10Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10]](9),
+	}
+}
+
+type Tuple11Struct[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+] struct {
+	value1  T1
+	value2  T2
+	value3  T3
+	value4  T4
+	value5  T5
+	value6  T6
+	value7  T7
+	value8  T8
+	value9  T9
+	value10 T10
+	value11 T11
+	expr1   TypedTableExpr[S, T1]
+	expr2   TypedTableExpr[S, T2]
+	expr3   TypedTableExpr[S, T3]
+	expr4   TypedTableExpr[S, T4]
+	expr5   TypedTableExpr[S, T5]
+	expr6   TypedTableExpr[S, T6]
+	expr7   TypedTableExpr[S, T7]
+	expr8   TypedTableExpr[S, T8]
+	expr9   TypedTableExpr[S, T9]
+	expr10  TypedTableExpr[S, T10]
+	expr11  TypedTableExpr[S, T11]
+}
+
+func Tuple11[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+](expr1 TypedTableExpr[S, T1], expr2 TypedTableExpr[S, T2], expr3 TypedTableExpr[S, T3], expr4 TypedTableExpr[S, T4], expr5 TypedTableExpr[S, T5], expr6 TypedTableExpr[S, T6], expr7 TypedTableExpr[S, T7], expr8 TypedTableExpr[S, T8], expr9 TypedTableExpr[S, T9], expr10 TypedTableExpr[S, T10], expr11 TypedTableExpr[S, T11]) *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11] {
+	return &Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]{
+		expr1:  expr1,
+		expr2:  expr2,
+		expr3:  expr3,
+		expr4:  expr4,
+		expr5:  expr5,
+		expr6:  expr6,
+		expr7:  expr7,
+		expr8:  expr8,
+		expr9:  expr9,
+		expr10: expr10,
+		expr11: expr11,
+	}
+}
+
+func (t *Tuple11Struct[_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _]) Exprs() []Expr {
+	return []Expr{t.expr1, t.expr2, t.expr3, t.expr4, t.expr5, t.expr6, t.expr7, t.expr8, t.expr9, t.expr10, t.expr11}
+}
+
+func (t *Tuple11Struct[_, _, U1, _, U2, _, U3, _, U4, _, U5, _, U6, _, U7, _, U8, _, U9, _, U10, _, U11]) Columns() []ColumnFieldExprType {
+	return []ColumnFieldExprType{
+		U1(&t.value1),
+		U2(&t.value2),
+		U3(&t.value3),
+		U4(&t.value4),
+		U5(&t.value5),
+		U6(&t.value6),
+		U7(&t.value7),
+		U8(&t.value8),
+		U9(&t.value9),
+		U10(&t.value10),
+		U11(&t.value11),
+	}
+}
+
+func (t *Tuple11Struct[_, T1, _, T2, _, T3, _, T4, _, T5, _, T6, _, T7, _, T8, _, T9, _, T10, _, T11, _]) Values() (T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11) {
+	return t.value1, t.value2, t.value3, t.value4, t.value5, t.value6, t.value7, t.value8, t.value9, t.value10, t.value11
+}
+
+// DerivedTuple11Struct typed columns of the derived table of Tuple11
+type DerivedTuple11Struct[
+	D Table,
+	T1 ExprType,
+	T2 ExprType,
+	T3 ExprType,
+	T4 ExprType,
+	T5 ExprType,
+	T6 ExprType,
+	T7 ExprType,
+	T8 ExprType,
+	T9 ExprType,
+	T10 ExprType,
+	T11 ExprType,
+] struct {
+	Value1      TypedTableColumns[D, T1]
+	Value1Expr  TypedTableExpr[D, T1]
+	Value2      TypedTableColumns[D, T2]
+	Value2Expr  TypedTableExpr[D, T2]
+	Value3      TypedTableColumns[D, T3]
+	Value3Expr  TypedTableExpr[D, T3]
+	Value4      TypedTableColumns[D, T4]
+	Value4Expr  TypedTableExpr[D, T4]
+	Value5      TypedTableColumns[D, T5]
+	Value5Expr  TypedTableExpr[D, T5]
+	Value6      TypedTableColumns[D, T6]
+	Value6Expr  TypedTableExpr[D, T6]
+	Value7      TypedTableColumns[D, T7]
+	Value7Expr  TypedTableExpr[D, T7]
+	Value8      TypedTableColumns[D, T8]
+	Value8Expr  TypedTableExpr[D, T8]
+	Value9      TypedTableColumns[D, T9]
+	Value9Expr  TypedTableExpr[D, T9]
+	Value10     TypedTableColumns[D, T10]
+	Value10Expr TypedTableExpr[D, T10]
+	Value11     TypedTableColumns[D, T11]
+	Value11Expr TypedTableExpr[D, T11]
+}
+
+// DerivedTuple11 typed columns of the derived table of Tuple11(alias_name.value1, alias_name.value2, ...)
+func DerivedTuple11[
+	A Alias,
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+](_ *DerivedTable[A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11], Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]]) *DerivedTuple11Struct[*DerivedTable[A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11], Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]], T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11] {
+	return &DerivedTuple11Struct[*DerivedTable[A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11], Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]], T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11]{
+		Value1:      newDerivedTypedColumn[T1, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](0),
+		Value1Expr:  newDerivedTypedColumn[T1, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](0),
+		Value2:      newDerivedTypedColumn[T2, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](1),
+		Value2Expr:  newDerivedTypedColumn[T2, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](1),
+		Value3:      newDerivedTypedColumn[T3, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](2),
+		Value3Expr:  newDerivedTypedColumn[T3, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](2),
+		Value4:      newDerivedTypedColumn[T4, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](3),
+		Value4Expr:  newDerivedTypedColumn[T4, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](3),
+		Value5:      newDerivedTypedColumn[T5, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](4),
+		Value5Expr:  newDerivedTypedColumn[T5, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](4),
+		Value6:      newDerivedTypedColumn[T6, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](5),
+		Value6Expr:  newDerivedTypedColumn[T6, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](5),
+		Value7:      newDerivedTypedColumn[T7, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](6),
+		Value7Expr:  newDerivedTypedColumn[T7, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](6),
+		Value8:      newDerivedTypedColumn[T8, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](7),
+		Value8Expr:  newDerivedTypedColumn[T8, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](7),
+		Value9:      newDerivedTypedColumn[T9, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](8),
+		Value9Expr:  newDerivedTypedColumn[T9, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](8),
+		Value10:     newDerivedTypedColumn[T10, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](9),
+		Value10Expr: newDerivedTypedColumn[T10, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](9),
+		Value11:     newDerivedTypedColumn[T11, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](10),
+		Value11Expr: newDerivedTypedColumn[T11, A, *Tuple11Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11]](10),
+	}
+}
+
+type Tuple12Struct[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+	T12 ExprType, U12 ColumnFieldExprTypePointer[T12],
+] struct {
+	value1  T1
+	value2  T2
+	value3  T3
+	value4  T4
+	value5  T5
+	value6  T6
+	value7  T7
+	value8  T8
+	value9  T9
+	value10 T10
+	value11 T11
+	value12 T12
+	expr1   TypedTableExpr[S, T1]
+	expr2   TypedTableExpr[S, T2]
+	expr3   TypedTableExpr[S, T3]
+	expr4   TypedTableExpr[S, T4]
+	expr5   TypedTableExpr[S, T5]
+	expr6   TypedTableExpr[S, T6]
+	expr7   TypedTableExpr[S, T7]
+	expr8   TypedTableExpr[S, T8]
+	expr9   TypedTableExpr[S, T9]
+	expr10  TypedTableExpr[S, T10]
+	expr11  TypedTableExpr[S, T11]
+	expr12  TypedTableExpr[S, T12]
+}
+
+func Tuple12[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+	T12 ExprType, U12 ColumnFieldExprTypePointer[T12],
+](expr1 TypedTableExpr[S, T1], expr2 TypedTableExpr[S, T2], expr3 TypedTableExpr[S, T3], expr4 TypedTableExpr[S, T4], expr5 TypedTableExpr[S, T5], expr6 TypedTableExpr[S, T6], expr7 TypedTableExpr[S, T7], expr8 TypedTableExpr[S, T8], expr9 TypedTableExpr[S, T9], expr10 TypedTableExpr[S, T10], expr11 TypedTableExpr[S, T11], expr12 TypedTableExpr[S, T12]) *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12] {
+	return &Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]{
+		expr1:  expr1,
+		expr2:  expr2,
+		expr3:  expr3,
+		expr4:  expr4,
+		expr5:  expr5,
+		expr6:  expr6,
+		expr7:  expr7,
+		expr8:  expr8,
+		expr9:  expr9,
+		expr10: expr10,
+		expr11: expr11,
+		expr12: expr12,
+	}
+}
+
+func (t *Tuple12Struct[_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _]) Exprs() []Expr {
+	return []Expr{t.expr1, t.expr2, t.expr3, t.expr4, t.expr5, t.expr6, t.expr7, t.expr8, t.expr9, t.expr10, t.expr11, t.expr12}
+}
+
+func (t *Tuple12Struct[_, _, U1, _, U2, _, U3, _, U4, _, U5, _, U6, _, U7, _, U8, _, U9, _, U10, _, U11, _, U12]) Columns() []ColumnFieldExprType {
+	return []ColumnFieldExprType{
+		U1(&t.value1),
+		U2(&t.value2),
+		U3(&t.value3),
+		U4(&t.value4),
+		U5(&t.value5),
+		U6(&t.value6),
+		U7(&t.value7),
+		U8(&t.value8),
+		U9(&t.value9),
+		U10(&t.value10),
+		U11(&t.value11),
+		U12(&t.value12),
+	}
+}
+
+func (t *Tuple12Struct[_, T1, _, T2, _, T3, _, T4, _, T5, _, T6, _, T7, _, T8, _, T9, _, T10, _, T11, _, T12, _]) Values() (T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12) {
+	return t.value1, t.value2, t.value3, t.value4, t.value5, t.value6, t.value7, t.value8, t.value9, t.value10, t.value11, t.value12
+}
+
+// DerivedTuple12Struct typed columns of the derived table of Tuple12
+type DerivedTuple12Struct[
+	D Table,
+	T1 ExprType,
+	T2 ExprType,
+	T3 ExprType,
+	T4 ExprType,
+	T5 ExprType,
+	T6 ExprType,
+	T7 ExprType,
+	T8 ExprType,
+	T9 ExprType,
+	T10 ExprType,
+	T11 ExprType,
+	T12 ExprType,
+] struct {
+	Value1      TypedTableColumns[D, T1]
+	Value1Expr  TypedTableExpr[D, T1]
+	Value2      TypedTableColumns[D, T2]
+	Value2Expr  TypedTableExpr[D, T2]
+	Value3      TypedTableColumns[D, T3]
+	Value3Expr  TypedTableExpr[D, T3]
+	Value4      TypedTableColumns[D, T4]
+	Value4Expr  TypedTableExpr[D, T4]
+	Value5      TypedTableColumns[D, T5]
+	Value5Expr  TypedTableExpr[D, T5]
+	Value6      TypedTableColumns[D, T6]
+	Value6Expr  TypedTableExpr[D, T6]
+	Value7      TypedTableColumns[D, T7]
+	Value7Expr  TypedTableExpr[D, T7]
+	Value8      TypedTableColumns[D, T8]
+	Value8Expr  TypedTableExpr[D, T8]
+	Value9      TypedTableColumns[D, T9]
+	Value9Expr  TypedTableExpr[D, T9]
+	Value10     TypedTableColumns[D, T10]
+	Value10Expr TypedTableExpr[D, T10]
+	Value11     TypedTableColumns[D, T11]
+	Value11Expr TypedTableExpr[D, T11]
+	Value12     TypedTableColumns[D, T12]
+	Value12Expr TypedTableExpr[D, T12]
+}
+
+// DerivedTuple12 typed columns of the derived table of Tuple12(alias_name.value1, alias_name.value2, ...)
+func DerivedTuple12[
+	A Alias,
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+	T12 ExprType, U12 ColumnFieldExprTypePointer[T12],
+](_ *DerivedTable[A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12], Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]]) *DerivedTuple12Struct[*DerivedTable[A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12], Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]], T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12] {
+	return &DerivedTuple12Struct[*DerivedTable[A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12], Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]], T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12]{
+		Value1:      newDerivedTypedColumn[T1, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](0),
+		Value1Expr:  newDerivedTypedColumn[T1, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](0),
+		Value2:      newDerivedTypedColumn[T2, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](1),
+		Value2Expr:  newDerivedTypedColumn[T2, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](1),
+		Value3:      newDerivedTypedColumn[T3, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](2),
+		Value3Expr:  newDerivedTypedColumn[T3, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](2),
+		Value4:      newDerivedTypedColumn[T4, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](3),
+		Value4Expr:  newDerivedTypedColumn[T4, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](3),
+		Value5:      newDerivedTypedColumn[T5, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](4),
+		Value5Expr:  newDerivedTypedColumn[T5, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](4),
+		Value6:      newDerivedTypedColumn[T6, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](5),
+		Value6Expr:  newDerivedTypedColumn[T6, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](5),
+		Value7:      newDerivedTypedColumn[T7, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](6),
+		Value7Expr:  newDerivedTypedColumn[T7, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](6),
+		Value8:      newDerivedTypedColumn[T8, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](7),
+		Value8Expr:  newDerivedTypedColumn[T8, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](7),
+		Value9:      newDerivedTypedColumn[T9, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](8),
+		Value9Expr:  newDerivedTypedColumn[T9, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](8),
+		Value10:     newDerivedTypedColumn[T10, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](9),
+		Value10Expr: newDerivedTypedColumn[T10, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](9),
+		Value11:     newDerivedTypedColumn[T11, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](10),
+		Value11Expr: newDerivedTypedColumn[T11, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](10),
+		Value12:     newDerivedTypedColumn[T12, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](11),
+		Value12Expr: newDerivedTypedColumn[T12, A, *Tuple12Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12]](11),
+	}
+}
+
+type Tuple13Struct[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+	T12 ExprType, U12 ColumnFieldExprTypePointer[T12],
+	T13 ExprType, U13 ColumnFieldExprTypePointer[T13],
+] struct {
+	value1  T1
+	value2  T2
+	value3  T3
+	value4  T4
+	value5  T5
+	value6  T6
+	value7  T7
+	value8  T8
+	value9  T9
+	value10 T10
+	value11 T11
+	value12 T12
+	value13 T13
+	expr1   TypedTableExpr[S, T1]
+	expr2   TypedTableExpr[S, T2]
+	expr3   TypedTableExpr[S, T3]
+	expr4   TypedTableExpr[S, T4]
+	expr5   TypedTableExpr[S, T5]
+	expr6   TypedTableExpr[S, T6]
+	expr7   TypedTableExpr[S, T7]
+	expr8   TypedTableExpr[S, T8]
+	expr9   TypedTableExpr[S, T9]
+	expr10  TypedTableExpr[S, T10]
+	expr11  TypedTableExpr[S, T11]
+	expr12  TypedTableExpr[S, T12]
+	expr13  TypedTableExpr[S, T13]
+}
+
+func Tuple13[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+	T12 ExprType, U12 ColumnFieldExprTypePointer[T12],
+	T13 ExprType, U13 ColumnFieldExprTypePointer[T13],
+](expr1 TypedTableExpr[S, T1], expr2 TypedTableExpr[S, T2], expr3 TypedTableExpr[S, T3], expr4 TypedTableExpr[S, T4], expr5 TypedTableExpr[S, T5], expr6 TypedTableExpr[S, T6], expr7 TypedTableExpr[S, T7], expr8 TypedTableExpr[S, T8], expr9 TypedTableExpr[S, T9], expr10 TypedTableExpr[S, T10], expr11 TypedTableExpr[S, T11], expr12 TypedTableExpr[S, T12], expr13 TypedTableExpr[S, T13]) *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13] {
+	return &Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]{
+		expr1:  expr1,
+		expr2:  expr2,
+		expr3:  expr3,
+		expr4:  expr4,
+		expr5:  expr5,
+		expr6:  expr6,
+		expr7:  expr7,
+		expr8:  expr8,
+		expr9:  expr9,
+		expr10: expr10,
+		expr11: expr11,
+		expr12: expr12,
+		expr13: expr13,
+	}
+}
+
+func (t *Tuple13Struct[_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _]) Exprs() []Expr {
+	return []Expr{t.expr1, t.expr2, t.expr3, t.expr4, t.expr5, t.expr6, t.expr7, t.expr8, t.expr9, t.expr10, t.expr11, t.expr12, t.expr13}
+}
+
+func (t *Tuple13Struct[_, _, U1, _, U2, _, U3, _, U4, _, U5, _, U6, _, U7, _, U8, _, U9, _, U10, _, U11, _, U12, _, U13]) Columns() []ColumnFieldExprType {
+	return []ColumnFieldExprType{
+		U1(&t.value1),
+		U2(&t.value2),
+		U3(&t.value3),
+		U4(&t.value4),
+		U5(&t.value5),
+		U6(&t.value6),
+		U7(&t.value7),
+		U8(&t.value8),
+		U9(&t.value9),
+		U10(&t.value10),
+		U11(&t.value11),
+		U12(&t.value12),
+		U13(&t.value13),
+	}
+}
+
+func (t *Tuple13Struct[_, T1, _, T2, _, T3, _, T4, _, T5, _, T6, _, T7, _, T8, _, T9, _, T10, _, T11, _, T12, _, T13, _]) Values() (T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13) {
+	return t.value1, t.value2, t.value3, t.value4, t.value5, t.value6, t.value7, t.value8, t.value9, t.value10, t.value11, t.value12, t.value13
+}
+
+// DerivedTuple13Struct typed columns of the derived table of Tuple13
+type DerivedTuple13Struct[
+	D Table,
+	T1 ExprType,
+	T2 ExprType,
+	T3 ExprType,
+	T4 ExprType,
+	T5 ExprType,
+	T6 ExprType,
+	T7 ExprType,
+	T8 ExprType,
+	T9 ExprType,
+	T10 ExprType,
+	T11 ExprType,
+	T12 ExprType,
+	T13 ExprType,
+] struct {
+	Value1      TypedTableColumns[D, T1]
+	Value1Expr  TypedTableExpr[D, T1]
+	Value2      TypedTableColumns[D, T2]
+	Value2Expr  TypedTableExpr[D, T2]
+	Value3      TypedTableColumns[D, T3]
+	Value3Expr  TypedTableExpr[D, T3]
+	Value4      TypedTableColumns[D, T4]
+	Value4Expr  TypedTableExpr[D, T4]
+	Value5      TypedTableColumns[D, T5]
+	Value5Expr  TypedTableExpr[D, T5]
+	Value6      TypedTableColumns[D, T6]
+	Value6Expr  TypedTableExpr[D, T6]
+	Value7      TypedTableColumns[D, T7]
+	Value7Expr  TypedTableExpr[D, T7]
+	Value8      TypedTableColumns[D, T8]
+	Value8Expr  TypedTableExpr[D, T8]
+	Value9      TypedTableColumns[D, T9]
+	Value9Expr  TypedTableExpr[D, T9]
+	Value10     TypedTableColumns[D, T10]
+	Value10Expr TypedTableExpr[D, T10]
+	Value11     TypedTableColumns[D, T11]
+	Value11Expr TypedTableExpr[D, T11]
+	Value12     TypedTableColumns[D, T12]
+	Value12Expr TypedTableExpr[D, T12]
+	Value13     TypedTableColumns[D, T13]
+	Value13Expr TypedTableExpr[D, T13]
+}
+
+// DerivedTuple13 typed columns of the derived table of Tuple13(alias_name.value1, alias_name.value2, ...)
+func DerivedTuple13[
+	A Alias,
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+	T12 ExprType, U12 ColumnFieldExprTypePointer[T12],
+	T13 ExprType, U13 ColumnFieldExprTypePointer[T13],
+](_ *DerivedTable[A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13], Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]]) *DerivedTuple13Struct[*DerivedTable[A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13], Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]], T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13] {
+	return &DerivedTuple13Struct[*DerivedTable[A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13], Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]], T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13]{
+		Value1:      newDerivedTypedColumn[T1, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](0),
+		Value1Expr:  newDerivedTypedColumn[T1, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](0),
+		Value2:      newDerivedTypedColumn[T2, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](1),
+		Value2Expr:  newDerivedTypedColumn[T2, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](1),
+		Value3:      newDerivedTypedColumn[T3, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](2),
+		Value3Expr:  newDerivedTypedColumn[T3, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](2),
+		Value4:      newDerivedTypedColumn[T4, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](3),
+		Value4Expr:  newDerivedTypedColumn[T4, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](3),
+		Value5:      newDerivedTypedColumn[T5, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](4),
+		Value5Expr:  newDerivedTypedColumn[T5, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](4),
+		Value6:      newDerivedTypedColumn[T6, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](5),
+		Value6Expr:  newDerivedTypedColumn[T6, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](5),
+		Value7:      newDerivedTypedColumn[T7, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](6),
+		Value7Expr:  newDerivedTypedColumn[T7, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](6),
+		Value8:      newDerivedTypedColumn[T8, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](7),
+		Value8Expr:  newDerivedTypedColumn[T8, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](7),
+		Value9:      newDerivedTypedColumn[T9, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](8),
+		Value9Expr:  newDerivedTypedColumn[T9, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](8),
+		Value10:     newDerivedTypedColumn[T10, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](9),
+		Value10Expr: newDerivedTypedColumn[T10, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](9),
+		Value11:     newDerivedTypedColumn[T11, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](10),
+		Value11Expr: newDerivedTypedColumn[T11, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](10),
+		Value12:     newDerivedTypedColumn[T12, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](11),
+		Value12Expr: newDerivedTypedColumn[T12, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](11),
+		Value13:     newDerivedTypedColumn[T13, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](12),
+		Value13Expr: newDerivedTypedColumn[T13, A, *Tuple13Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13]](12),
+	}
+}
+
+type Tuple14Struct[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+	T12 ExprType, U12 ColumnFieldExprTypePointer[T12],
+	T13 ExprType, U13 ColumnFieldExprTypePointer[T13],
+	T14 ExprType, U14 ColumnFieldExprTypePointer[T14],
+] struct {
+	value1  T1
+	value2  T2
+	value3  T3
+	value4  T4
+	value5  T5
+	value6  T6
+	value7  T7
+	value8  T8
+	value9  T9
+	value10 T10
+	value11 T11
+	value12 T12
+	value13 T13
+	value14 T14
+	expr1   TypedTableExpr[S, T1]
+	expr2   TypedTableExpr[S, T2]
+	expr3   TypedTableExpr[S, T3]
+	expr4   TypedTableExpr[S, T4]
+	expr5   TypedTableExpr[S, T5]
+	expr6   TypedTableExpr[S, T6]
+	expr7   TypedTableExpr[S, T7]
+	expr8   TypedTableExpr[S, T8]
+	expr9   TypedTableExpr[S, T9]
+	expr10  TypedTableExpr[S, T10]
+	expr11  TypedTableExpr[S, T11]
+	expr12  TypedTableExpr[S, T12]
+	expr13  TypedTableExpr[S, T13]
+	expr14  TypedTableExpr[S, T14]
+}
+
+func Tuple14[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+	T12 ExprType, U12 ColumnFieldExprTypePointer[T12],
+	T13 ExprType, U13 ColumnFieldExprTypePointer[T13],
+	T14 ExprType, U14 ColumnFieldExprTypePointer[T14],
+](expr1 TypedTableExpr[S, T1], expr2 TypedTableExpr[S, T2], expr3 TypedTableExpr[S, T3], expr4 TypedTableExpr[S, T4], expr5 TypedTableExpr[S, T5], expr6 TypedTableExpr[S, T6], expr7 TypedTableExpr[S, T7], expr8 TypedTableExpr[S, T8], expr9 TypedTableExpr[S, T9], expr10 TypedTableExpr[S, T10], expr11 TypedTableExpr[S, T11], expr12 TypedTableExpr[S, T12], expr13 TypedTableExpr[S, T13], expr14 TypedTableExpr[S, T14]) *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14] {
+	return &Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]{
+		expr1:  expr1,
+		expr2:  expr2,
+		expr3:  expr3,
+		expr4:  expr4,
+		expr5:  expr5,
+		expr6:  expr6,
+		expr7:  expr7,
+		expr8:  expr8,
+		expr9:  expr9,
+		expr10: expr10,
+		expr11: expr11,
+		expr12: expr12,
+		expr13: expr13,
+		expr14: expr14,
+	}
+}
+
+func (t *Tuple14Struct[_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _]) Exprs() []Expr {
+	return []Expr{t.expr1, t.expr2, t.expr3, t.expr4, t.expr5, t.expr6, t.expr7, t.expr8, t.expr9, t.expr10, t.expr11, t.expr12, t.expr13, t.expr14}
+}
+
+func (t *Tuple14Struct[_, _, U1, _, U2, _, U3, _, U4, _, U5, _, U6, _, U7, _, U8, _, U9, _, U10, _, U11, _, U12, _, U13, _, U14]) Columns() []ColumnFieldExprType {
+	return []ColumnFieldExprType{
+		U1(&t.value1),
+		U2(&t.value2),
+		U3(&t.value3),
+		U4(&t.value4),
+		U5(&t.value5),
+		U6(&t.value6),
+		U7(&t.value7),
+		U8(&t.value8),
+		U9(&t.value9),
+		U10(&t.value10),
+		U11(&t.value11),
+		U12(&t.value12),
+		U13(&t.value13),
+		U14(&t.value14),
+	}
+}
+
+func (t *Tuple14Struct[_, T1, _, T2, _, T3, _, T4, _, T5, _, T6, _, T7, _, T8, _, T9, _, T10, _, T11, _, T12, _, T13, _, T14, _]) Values() (T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14) {
+	return t.value1, t.value2, t.value3, t.value4, t.value5, t.value6, t.value7, t.value8, t.value9, t.value10, t.value11, t.value12, t.value13, t.value14
+}
+
+// DerivedTuple14Struct typed columns of the derived table of Tuple14
+type DerivedTuple14Struct[
+	D Table,
+	T1 ExprType,
+	T2 ExprType,
+	T3 ExprType,
+	T4 ExprType,
+	T5 ExprType,
+	T6 ExprType,
+	T7 ExprType,
+	T8 ExprType,
+	T9 ExprType,
+	T10 ExprType,
+	T11 ExprType,
+	T12 ExprType,
+	T13 ExprType,
+	T14 ExprType,
+] struct {
+	Value1      TypedTableColumns[D, T1]
+	Value1Expr  TypedTableExpr[D, T1]
+	Value2      TypedTableColumns[D, T2]
+	Value2Expr  TypedTableExpr[D, T2]
+	Value3      TypedTableColumns[D, T3]
+	Value3Expr  TypedTableExpr[D, T3]
+	Value4      TypedTableColumns[D, T4]
+	Value4Expr  TypedTableExpr[D, T4]
+	Value5      TypedTableColumns[D, T5]
+	Value5Expr  TypedTableExpr[D, T5]
+	Value6      TypedTableColumns[D, T6]
+	Value6Expr  TypedTableExpr[D, T6]
+	Value7      TypedTableColumns[D, T7]
+	Value7Expr  TypedTableExpr[D, T7]
+	Value8      TypedTableColumns[D, T8]
+	Value8Expr  TypedTableExpr[D, T8]
+	Value9      TypedTableColumns[D, T9]
+	Value9Expr  TypedTableExpr[D, T9]
+	Value10     TypedTableColumns[D, T10]
+	Value10Expr TypedTableExpr[D, T10]
+	Value11     TypedTableColumns[D, T11]
+	Value11Expr TypedTableExpr[D, T11]
+	Value12     TypedTableColumns[D, T12]
+	Value12Expr TypedTableExpr[D, T12]
+	Value13     TypedTableColumns[D, T13]
+	Value13Expr TypedTableExpr[D, T13]
+	Value14     TypedTableColumns[D, T14]
+	Value14Expr TypedTableExpr[D, T14]
+}
+
+// DerivedTuple14 typed columns of the derived table of Tuple14(alias_name.value1, alias_name.value2, ...)
+func DerivedTuple14[
+	A Alias,
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+	T12 ExprType, U12 ColumnFieldExprTypePointer[T12],
+	T13 ExprType, U13 ColumnFieldExprTypePointer[T13],
+	T14 ExprType, U14 ColumnFieldExprTypePointer[T14],
+](_ *DerivedTable[A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14], Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]]) *DerivedTuple14Struct[*DerivedTable[A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14], Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]], T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14] {
+	return &DerivedTuple14Struct[*DerivedTable[A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14], Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]], T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14]{
+		Value1:      newDerivedTypedColumn[T1, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](0),
+		Value1Expr:  newDerivedTypedColumn[T1, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](0),
+		Value2:      newDerivedTypedColumn[T2, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](1),
+		Value2Expr:  newDerivedTypedColumn[T2, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](1),
+		Value3:      newDerivedTypedColumn[T3, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](2),
+		Value3Expr:  newDerivedTypedColumn[T3, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](2),
+		Value4:      newDerivedTypedColumn[T4, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](3),
+		Value4Expr:  newDerivedTypedColumn[T4, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](3),
+		Value5:      newDerivedTypedColumn[T5, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](4),
+		Value5Expr:  newDerivedTypedColumn[T5, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](4),
+		Value6:      newDerivedTypedColumn[T6, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](5),
+		Value6Expr:  newDerivedTypedColumn[T6, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](5),
+		Value7:      newDerivedTypedColumn[T7, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](6),
+		Value7Expr:  newDerivedTypedColumn[T7, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](6),
+		Value8:      newDerivedTypedColumn[T8, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](7),
+		Value8Expr:  newDerivedTypedColumn[T8, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](7),
+		Value9:      newDerivedTypedColumn[T9, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](8),
+		Value9Expr:  newDerivedTypedColumn[T9, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](8),
+		Value10:     newDerivedTypedColumn[T10, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](9),
+		Value10Expr: newDerivedTypedColumn[T10, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](9),
+		Value11:     newDerivedTypedColumn[T11, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](10),
+		Value11Expr: newDerivedTypedColumn[T11, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](10),
+		Value12:     newDerivedTypedColumn[T12, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](11),
+		Value12Expr: newDerivedTypedColumn[T12, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](11),
+		Value13:     newDerivedTypedColumn[T13, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](12),
+		Value13Expr: newDerivedTypedColumn[T13, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](12),
+		Value14:     newDerivedTypedColumn[T14, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](13),
+		Value14Expr: newDerivedTypedColumn[T14, A, *Tuple14Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14]](13),
+	}
+}
+
+type Tuple15Struct[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+	T12 ExprType, U12 ColumnFieldExprTypePointer[T12],
+	T13 ExprType, U13 ColumnFieldExprTypePointer[T13],
+	T14 ExprType, U14 ColumnFieldExprTypePointer[T14],
+	T15 ExprType, U15 ColumnFieldExprTypePointer[T15],
+] struct {
+	value1  T1
+	value2  T2
+	value3  T3
+	value4  T4
+	value5  T5
+	value6  T6
+	value7  T7
+	value8  T8
+	value9  T9
+	value10 T10
+	value11 T11
+	value12 T12
+	value13 T13
+	value14 T14
+	value15 T15
+	expr1   TypedTableExpr[S, T1]
+	expr2   TypedTableExpr[S, T2]
+	expr3   TypedTableExpr[S, T3]
+	expr4   TypedTableExpr[S, T4]
+	expr5   TypedTableExpr[S, T5]
+	expr6   TypedTableExpr[S, T6]
+	expr7   TypedTableExpr[S, T7]
+	expr8   TypedTableExpr[S, T8]
+	expr9   TypedTableExpr[S, T9]
+	expr10  TypedTableExpr[S, T10]
+	expr11  TypedTableExpr[S, T11]
+	expr12  TypedTableExpr[S, T12]
+	expr13  TypedTableExpr[S, T13]
+	expr14  TypedTableExpr[S, T14]
+	expr15  TypedTableExpr[S, T15]
+}
+
+func Tuple15[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+	T12 ExprType, U12 ColumnFieldExprTypePointer[T12],
+	T13 ExprType, U13 ColumnFieldExprTypePointer[T13],
+	T14 ExprType, U14 ColumnFieldExprTypePointer[T14],
+	T15 ExprType, U15 ColumnFieldExprTypePointer[T15],
+](expr1 TypedTableExpr[S, T1], expr2 TypedTableExpr[S, T2], expr3 TypedTableExpr[S, T3], expr4 TypedTableExpr[S, T4], expr5 TypedTableExpr[S, T5], expr6 TypedTableExpr[S, T6], expr7 TypedTableExpr[S, T7], expr8 TypedTableExpr[S, T8], expr9 TypedTableExpr[S, T9], expr10 TypedTableExpr[S, T10], expr11 TypedTableExpr[S, T11], expr12 TypedTableExpr[S, T12], expr13 TypedTableExpr[S, T13], expr14 TypedTableExpr[S, T14], expr15 TypedTableExpr[S, T15]) *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15] {
+	return &Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]{
+		expr1:  expr1,
+		expr2:  expr2,
+		expr3:  expr3,
+		expr4:  expr4,
+		expr5:  expr5,
+		expr6:  expr6,
+		expr7:  expr7,
+		expr8:  expr8,
+		expr9:  expr9,
+		expr10: expr10,
+		expr11: expr11,
+		expr12: expr12,
+		expr13: expr13,
+		expr14: expr14,
+		expr15: expr15,
+	}
+}
+
+func (t *Tuple15Struct[_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _]) Exprs() []Expr {
+	return []Expr{t.expr1, t.expr2, t.expr3, t.expr4, t.expr5, t.expr6, t.expr7, t.expr8, t.expr9, t.expr10, t.expr11, t.expr12, t.expr13, t.expr14, t.expr15}
+}
+
+func (t *Tuple15Struct[_, _, U1, _, U2, _, U3, _, U4, _, U5, _, U6, _, U7, _, U8, _, U9, _, U10, _, U11, _, U12, _, U13, _, U14, _, U15]) Columns() []ColumnFieldExprType {
+	return []ColumnFieldExprType{
+		U1(&t.value1),
+		U2(&t.value2),
+		U3(&t.value3),
+		U4(&t.value4),
+		U5(&t.value5),
+		U6(&t.value6),
+		U7(&t.value7),
+		U8(&t.value8),
+		U9(&t.value9),
+		U10(&t.value10),
+		U11(&t.value11),
+		U12(&t.value12),
+		U13(&t.value13),
+		U14(&t.value14),
+		U15(&t.value15),
+	}
+}
+
+func (t *Tuple15Struct[_, T1, _, T2, _, T3, _, T4, _, T5, _, T6, _, T7, _, T8, _, T9, _, T10, _, T11, _, T12, _, T13, _, T14, _, T15, _]) Values() (T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15) {
+	return t.value1, t.value2, t.value3, t.value4, t.value5, t.value6, t.value7, t.value8, t.value9, t.value10, t.value11, t.value12, t.value13, t.value14, t.value15
+}
+
+// DerivedTuple15Struct typed columns of the derived table of Tuple15
+type DerivedTuple15Struct[
+	D Table,
+	T1 ExprType,
+	T2 ExprType,
+	T3 ExprType,
+	T4 ExprType,
+	T5 ExprType,
+	T6 ExprType,
+	T7 ExprType,
+	T8 ExprType,
+	T9 ExprType,
+	T10 ExprType,
+	T11 ExprType,
+	T12 ExprType,
+	T13 ExprType,
+	T14 ExprType,
+	T15 ExprType,
+] struct {
+	Value1      TypedTableColumns[D, T1]
+	Value1Expr  TypedTableExpr[D, T1]
+	Value2      TypedTableColumns[D, T2]
+	Value2Expr  TypedTableExpr[D, T2]
+	Value3      TypedTableColumns[D, T3]
+	Value3Expr  TypedTableExpr[D, T3]
+	Value4      TypedTableColumns[D, T4]
+	Value4Expr  TypedTableExpr[D, T4]
+	Value5      TypedTableColumns[D, T5]
+	Value5Expr  TypedTableExpr[D, T5]
+	Value6      TypedTableColumns[D, T6]
+	Value6Expr  TypedTableExpr[D, T6]
+	Value7      TypedTableColumns[D, T7]
+	Value7Expr  TypedTableExpr[D, T7]
+	Value8      TypedTableColumns[D, T8]
+	Value8Expr  TypedTableExpr[D, T8]
+	Value9      TypedTableColumns[D, T9]
+	Value9Expr  TypedTableExpr[D, T9]
+	Value10     TypedTableColumns[D, T10]
+	Value10Expr TypedTableExpr[D, T10]
+	Value11     TypedTableColumns[D, T11]
+	Value11Expr TypedTableExpr[D, T11]
+	Value12     TypedTableColumns[D, T12]
+	Value12Expr TypedTableExpr[D, T12]
+	Value13     TypedTableColumns[D, T13]
+	Value13Expr TypedTableExpr[D, T13]
+	Value14     TypedTableColumns[D, T14]
+	Value14Expr TypedTableExpr[D, T14]
+	Value15     TypedTableColumns[D, T15]
+	Value15Expr TypedTableExpr[D, T15]
+}
+
+// DerivedTuple15 typed columns of the derived table of Tuple15(alias_name.value1, alias_name.value2, ...)
+func DerivedTuple15[
+	A Alias,
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+	T12 ExprType, U12 ColumnFieldExprTypePointer[T12],
+	T13 ExprType, U13 ColumnFieldExprTypePointer[T13],
+	T14 ExprType, U14 ColumnFieldExprTypePointer[T14],
+	T15 ExprType, U15 ColumnFieldExprTypePointer[T15],
+](_ *DerivedTable[A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15], Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]]) *DerivedTuple15Struct[*DerivedTable[A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15], Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]], T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15] {
+	return &DerivedTuple15Struct[*DerivedTable[A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15], Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]], T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15]{
+		Value1:      newDerivedTypedColumn[T1, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](0),
+		Value1Expr:  newDerivedTypedColumn[T1, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](0),
+		Value2:      newDerivedTypedColumn[T2, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](1),
+		Value2Expr:  newDerivedTypedColumn[T2, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](1),
+		Value3:      newDerivedTypedColumn[T3, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](2),
+		Value3Expr:  newDerivedTypedColumn[T3, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](2),
+		Value4:      newDerivedTypedColumn[T4, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](3),
+		Value4Expr:  newDerivedTypedColumn[T4, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](3),
+		Value5:      newDerivedTypedColumn[T5, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](4),
+		Value5Expr:  newDerivedTypedColumn[T5, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](4),
+		Value6:      newDerivedTypedColumn[T6, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](5),
+		Value6Expr:  newDerivedTypedColumn[T6, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](5),
+		Value7:      newDerivedTypedColumn[T7, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](6),
+		Value7Expr:  newDerivedTypedColumn[T7, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](6),
+		Value8:      newDerivedTypedColumn[T8, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](7),
+		Value8Expr:  newDerivedTypedColumn[T8, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](7),
+		Value9:      newDerivedTypedColumn[T9, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](8),
+		Value9Expr:  newDerivedTypedColumn[T9, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](8),
+		Value10:     newDerivedTypedColumn[T10, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](9),
+		Value10Expr: newDerivedTypedColumn[T10, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](9),
+		Value11:     newDerivedTypedColumn[T11, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](10),
+		Value11Expr: newDerivedTypedColumn[T11, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](10),
+		Value12:     newDerivedTypedColumn[T12, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](11),
+		Value12Expr: newDerivedTypedColumn[T12, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](11),
+		Value13:     newDerivedTypedColumn[T13, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](12),
+		Value13Expr: newDerivedTypedColumn[T13, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](12),
+		Value14:     newDerivedTypedColumn[T14, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](13),
+		Value14Expr: newDerivedTypedColumn[T14, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](13),
+		Value15:     newDerivedTypedColumn[T15, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](14),
+		Value15Expr: newDerivedTypedColumn[T15, A, *Tuple15Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15]](14),
+	}
+}
+
+type Tuple16Struct[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+	T12 ExprType, U12 ColumnFieldExprTypePointer[T12],
+	T13 ExprType, U13 ColumnFieldExprTypePointer[T13],
+	T14 ExprType, U14 ColumnFieldExprTypePointer[T14],
+	T15 ExprType, U15 ColumnFieldExprTypePointer[T15],
+	T16 ExprType, U16 ColumnFieldExprTypePointer[T16],
+] struct {
+	value1  T1
+	value2  T2
+	value3  T3
+	value4  T4
+	value5  T5
+	value6  T6
+	value7  T7
+	value8  T8
+	value9  T9
+	value10 T10
+	value11 T11
+	value12 T12
+	value13 T13
+	value14 T14
+	value15 T15
+	value16 T16
+	expr1   TypedTableExpr[S, T1]
+	expr2   TypedTableExpr[S, T2]
+	expr3   TypedTableExpr[S, T3]
+	expr4   TypedTableExpr[S, T4]
+	expr5   TypedTableExpr[S, T5]
+	expr6   TypedTableExpr[S, T6]
+	expr7   TypedTableExpr[S, T7]
+	expr8   TypedTableExpr[S, T8]
+	expr9   TypedTableExpr[S, T9]
+	expr10  TypedTableExpr[S, T10]
+	expr11  TypedTableExpr[S, T11]
+	expr12  TypedTableExpr[S, T12]
+	expr13  TypedTableExpr[S, T13]
+	expr14  TypedTableExpr[S, T14]
+	expr15  TypedTableExpr[S, T15]
+	expr16  TypedTableExpr[S, T16]
+}
+
+func Tuple16[
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+	T12 ExprType, U12 ColumnFieldExprTypePointer[T12],
+	T13 ExprType, U13 ColumnFieldExprTypePointer[T13],
+	T14 ExprType, U14 ColumnFieldExprTypePointer[T14],
+	T15 ExprType, U15 ColumnFieldExprTypePointer[T15],
+	T16 ExprType, U16 ColumnFieldExprTypePointer[T16],
+](expr1 TypedTableExpr[S, T1], expr2 TypedTableExpr[S, T2], expr3 TypedTableExpr[S, T3], expr4 TypedTableExpr[S, T4], expr5 TypedTableExpr[S, T5], expr6 TypedTableExpr[S, T6], expr7 TypedTableExpr[S, T7], expr8 TypedTableExpr[S, T8], expr9 TypedTableExpr[S, T9], expr10 TypedTableExpr[S, T10], expr11 TypedTableExpr[S, T11], expr12 TypedTableExpr[S, T12], expr13 TypedTableExpr[S, T13], expr14 TypedTableExpr[S, T14], expr15 TypedTableExpr[S, T15], expr16 TypedTableExpr[S, T16]) *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16] {
+	return &Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]{
+		expr1:  expr1,
+		expr2:  expr2,
+		expr3:  expr3,
+		expr4:  expr4,
+		expr5:  expr5,
+		expr6:  expr6,
+		expr7:  expr7,
+		expr8:  expr8,
+		expr9:  expr9,
+		expr10: expr10,
+		expr11: expr11,
+		expr12: expr12,
+		expr13: expr13,
+		expr14: expr14,
+		expr15: expr15,
+		expr16: expr16,
+	}
+}
+
+func (t *Tuple16Struct[_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _]) Exprs() []Expr {
+	return []Expr{t.expr1, t.expr2, t.expr3, t.expr4, t.expr5, t.expr6, t.expr7, t.expr8, t.expr9, t.expr10, t.expr11, t.expr12, t.expr13, t.expr14, t.expr15, t.expr16}
+}
+
+func (t *Tuple16Struct[_, _, U1, _, U2, _, U3, _, U4, _, U5, _, U6, _, U7, _, U8, _, U9, _, U10, _, U11, _, U12, _, U13, _, U14, _, U15, _, U16]) Columns() []ColumnFieldExprType {
+	return []ColumnFieldExprType{
+		U1(&t.value1),
+		U2(&t.value2),
+		U3(&t.value3),
+		U4(&t.value4),
+		U5(&t.value5),
+		U6(&t.value6),
+		U7(&t.value7),
+		U8(&t.value8),
+		U9(&t.value9),
+		U10(&t.value10),
+		U11(&t.value11),
+		U12(&t.value12),
+		U13(&t.value13),
+		U14(&t.value14),
+		U15(&t.value15),
+		U16(&t.value16),
+	}
+}
+
+func (t *Tuple16Struct[_, T1, _, T2, _, T3, _, T4, _, T5, _, T6, _, T7, _, T8, _, T9, _, T10, _, T11, _, T12, _, T13, _, T14, _, T15, _, T16, _]) Values() (T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, T16) {
+	return t.value1, t.value2, t.value3, t.value4, t.value5, t.value6, t.value7, t.value8, t.value9, t.value10, t.value11, t.value12, t.value13, t.value14, t.value15, t.value16
+}
+
+// DerivedTuple16Struct typed columns of the derived table of Tuple16
+type DerivedTuple16Struct[
+	D Table,
+	T1 ExprType,
+	T2 ExprType,
+	T3 ExprType,
+	T4 ExprType,
+	T5 ExprType,
+	T6 ExprType,
+	T7 ExprType,
+	T8 ExprType,
+	T9 ExprType,
+	T10 ExprType,
+	T11 ExprType,
+	T12 ExprType,
+	T13 ExprType,
+	T14 ExprType,
+	T15 ExprType,
+	T16 ExprType,
+] struct {
+	Value1      TypedTableColumns[D, T1]
+	Value1Expr  TypedTableExpr[D, T1]
+	Value2      TypedTableColumns[D, T2]
+	Value2Expr  TypedTableExpr[D, T2]
+	Value3      TypedTableColumns[D, T3]
+	Value3Expr  TypedTableExpr[D, T3]
+	Value4      TypedTableColumns[D, T4]
+	Value4Expr  TypedTableExpr[D, T4]
+	Value5      TypedTableColumns[D, T5]
+	Value5Expr  TypedTableExpr[D, T5]
+	Value6      TypedTableColumns[D, T6]
+	Value6Expr  TypedTableExpr[D, T6]
+	Value7      TypedTableColumns[D, T7]
+	Value7Expr  TypedTableExpr[D, T7]
+	Value8      TypedTableColumns[D, T8]
+	Value8Expr  TypedTableExpr[D, T8]
+	Value9      TypedTableColumns[D, T9]
+	Value9Expr  TypedTableExpr[D, T9]
+	Value10     TypedTableColumns[D, T10]
+	Value10Expr TypedTableExpr[D, T10]
+	Value11     TypedTableColumns[D, T11]
+	Value11Expr TypedTableExpr[D, T11]
+	Value12     TypedTableColumns[D, T12]
+	Value12Expr TypedTableExpr[D, T12]
+	Value13     TypedTableColumns[D, T13]
+	Value13Expr TypedTableExpr[D, T13]
+	Value14     TypedTableColumns[D, T14]
+	Value14Expr TypedTableExpr[D, T14]
+	Value15     TypedTableColumns[D, T15]
+	Value15Expr TypedTableExpr[D, T15]
+	Value16     TypedTableColumns[D, T16]
+	Value16Expr TypedTableExpr[D, T16]
+}
+
+// DerivedTuple16 typed columns of the derived table of Tuple16(alias_name.value1, alias_name.value2, ...)
+func DerivedTuple16[
+	A Alias,
+	S Table,
+	T1 ExprType, U1 ColumnFieldExprTypePointer[T1],
+	T2 ExprType, U2 ColumnFieldExprTypePointer[T2],
+	T3 ExprType, U3 ColumnFieldExprTypePointer[T3],
+	T4 ExprType, U4 ColumnFieldExprTypePointer[T4],
+	T5 ExprType, U5 ColumnFieldExprTypePointer[T5],
+	T6 ExprType, U6 ColumnFieldExprTypePointer[T6],
+	T7 ExprType, U7 ColumnFieldExprTypePointer[T7],
+	T8 ExprType, U8 ColumnFieldExprTypePointer[T8],
+	T9 ExprType, U9 ColumnFieldExprTypePointer[T9],
+	T10 ExprType, U10 ColumnFieldExprTypePointer[T10],
+	T11 ExprType, U11 ColumnFieldExprTypePointer[T11],
+	T12 ExprType, U12 ColumnFieldExprTypePointer[T12],
+	T13 ExprType, U13 ColumnFieldExprTypePointer[T13],
+	T14 ExprType, U14 ColumnFieldExprTypePointer[T14],
+	T15 ExprType, U15 ColumnFieldExprTypePointer[T15],
+	T16 ExprType, U16 ColumnFieldExprTypePointer[T16],
+](_ *DerivedTable[A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16], Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]]) *DerivedTuple16Struct[*DerivedTable[A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16], Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]], T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, T16] {
+	return &DerivedTuple16Struct[*DerivedTable[A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16], Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]], T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15, T16]{
+		Value1:      newDerivedTypedColumn[T1, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](0),
+		Value1Expr:  newDerivedTypedColumn[T1, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](0),
+		Value2:      newDerivedTypedColumn[T2, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](1),
+		Value2Expr:  newDerivedTypedColumn[T2, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](1),
+		Value3:      newDerivedTypedColumn[T3, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](2),
+		Value3Expr:  newDerivedTypedColumn[T3, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](2),
+		Value4:      newDerivedTypedColumn[T4, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](3),
+		Value4Expr:  newDerivedTypedColumn[T4, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](3),
+		Value5:      newDerivedTypedColumn[T5, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](4),
+		Value5Expr:  newDerivedTypedColumn[T5, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](4),
+		Value6:      newDerivedTypedColumn[T6, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](5),
+		Value6Expr:  newDerivedTypedColumn[T6, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](5),
+		Value7:      newDerivedTypedColumn[T7, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](6),
+		Value7Expr:  newDerivedTypedColumn[T7, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](6),
+		Value8:      newDerivedTypedColumn[T8, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](7),
+		Value8Expr:  newDerivedTypedColumn[T8, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](7),
+		Value9:      newDerivedTypedColumn[T9, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](8),
+		Value9Expr:  newDerivedTypedColumn[T9, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](8),
+		Value10:     newDerivedTypedColumn[T10, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](9),
+		Value10Expr: newDerivedTypedColumn[T10, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](9),
+		Value11:     newDerivedTypedColumn[T11, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](10),
+		Value11Expr: newDerivedTypedColumn[T11, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](10),
+		Value12:     newDerivedTypedColumn[T12, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](11),
+		Value12Expr: newDerivedTypedColumn[T12, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](11),
+		Value13:     newDerivedTypedColumn[T13, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](12),
+		Value13Expr: newDerivedTypedColumn[T13, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](12),
+		Value14:     newDerivedTypedColumn[T14, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](13),
+		Value14Expr: newDerivedTypedColumn[T14, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](13),
+		Value15:     newDerivedTypedColumn[T15, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](14),
+		Value15Expr: newDerivedTypedColumn[T15, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](14),
+		Value16:     newDerivedTypedColumn[T16, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](15),
+		Value16Expr: newDerivedTypedColumn[T16, A, *Tuple16Struct[S, T1, U1, T2, U2, T3, U3, T4, U4, T5, U5, T6, U6, T7, U7, T8, U8, T9, U9, T10, U10, T11, U11, T12, U12, T13, U13, T14, U14, T15, U15, T16, U16]](15),
+	}
+}
@@ -0,0 +1,48 @@
+package genorm_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mazrean/genorm"
+	"github.com/mazrean/genorm/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTuple8(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	exprs := make([]genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]], 0, 8)
+	for range 8 {
+		exprs = append(exprs, mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]](ctrl))
+	}
+
+	tuple := genorm.Tuple8(exprs[0], exprs[1], exprs[2], exprs[3], exprs[4], exprs[5], exprs[6], exprs[7])
+
+	tupleExprs := tuple.Exprs()
+	if assert.Len(t, tupleExprs, len(exprs)) {
+		for i, expr := range exprs {
+			assert.Same(t, expr, tupleExprs[i])
+		}
+	}
+
+	columns := tuple.Columns()
+	if !assert.Len(t, columns, len(exprs)) {
+		return
+	}
+
+	for i, column := range columns {
+		err := column.Scan(int64(i + 1))
+		if !assert.NoError(t, err) {
+			return
+		}
+	}
+
+	v1, v2, v3, v4, v5, v6, v7, v8 := tuple.Values()
+	assert.Equal(t, []genorm.WrappedPrimitive[int64]{
+		genorm.Wrap(int64(1)), genorm.Wrap(int64(2)), genorm.Wrap(int64(3)), genorm.Wrap(int64(4)),
+		genorm.Wrap(int64(5)), genorm.Wrap(int64(6)), genorm.Wrap(int64(7)), genorm.Wrap(int64(8)),
+	}, []genorm.WrappedPrimitive[int64]{v1, v2, v3, v4, v5, v6, v7, v8})
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"errors"
+	"reflect"
 	"time"
 )
 
@@ -29,6 +30,19 @@ type ExprPrimitive interface {
 		string | time.Time
 }
 
+type ExprIntegerPrimitive interface {
+	int | int8 | int16 | int32 | int64 |
+		uint | uint8 | uint16 | uint32 | uint64
+}
+
+type ExprFloatPrimitive interface {
+	float32 | float64
+}
+
+type ExprNumericPrimitive interface {
+	ExprIntegerPrimitive | ExprFloatPrimitive
+}
+
 type WrappedPrimitive[T ExprPrimitive] struct {
 	valid bool
 	val   T
@@ -198,3 +212,7 @@ func (wp WrappedPrimitive[_]) Value() (driver.Value, error) {
 func (wp WrappedPrimitive[T]) Val() (T, bool) {
 	return wp.val, wp.valid
 }
+
+func (wp WrappedPrimitive[T]) primitiveType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 )
 
@@ -23,13 +24,22 @@ func Update[T Table](table T) *UpdateContext[T] {
 	}
 }
 
+func (c *UpdateContext[T]) clone() *UpdateContext[T] {
+	clone := *c
+	clone.Context = c.Context.clone()
+
+	return &clone
+}
+
 func (c *UpdateContext[T]) Set(assignExprs ...*TableAssignExpr[T]) (res *UpdateContext[T]) {
+	c = c.clone()
+
 	if len(assignExprs) == 0 {
 		c.addError(errors.New("no assign expressions"))
 		return c
 	}
 
-	c.assignExprs = append(c.assignExprs, assignExprs...)
+	c.assignExprs = append(slices.Clip(c.assignExprs), assignExprs...)
 
 	return c
 }
@@ -37,6 +47,8 @@ func (c *UpdateContext[T]) Set(assignExprs ...*TableAssignExpr[T]) (res *UpdateC
 func (c *UpdateContext[T]) Where(
 	condition TypedTableExpr[T, WrappedPrimitive[bool]],
 ) *UpdateContext[T] {
+	c = c.clone()
+
 	err := c.whereCondition.set(condition)
 	if err != nil {
 		c.addError(fmt.Errorf("where condition: %w", err))
@@ -45,7 +57,22 @@ func (c *UpdateContext[T]) Where(
 	return c
 }
 
+func (c *UpdateContext[T]) OrWhere(
+	condition TypedTableExpr[T, WrappedPrimitive[bool]],
+) *UpdateContext[T] {
+	c = c.clone()
+
+	err := c.whereCondition.or(condition)
+	if err != nil {
+		c.addError(fmt.Errorf("where condition: %w", err))
+	}
+
+	return c
+}
+
 func (c *UpdateContext[T]) OrderBy(direction OrderDirection, expr TableExpr[T]) *UpdateContext[T] {
+	c = c.clone()
+
 	err := c.order.add(orderItem[T]{
 		expr:      expr,
 		direction: direction,
@@ -58,6 +85,8 @@ func (c *UpdateContext[T]) OrderBy(direction OrderDirection, expr TableExpr[T])
 }
 
 func (c *UpdateContext[T]) Limit(limit uint64) *UpdateContext[T] {
+	c = c.clone()
+
 	err := c.limit.set(limit)
 	if err != nil {
 		c.addError(fmt.Errorf("limit: %w", err))
@@ -110,9 +139,9 @@ func (c *UpdateContext[T]) buildQuery() (string, []ExprType, error) {
 		return "", nil, fmt.Errorf("write string(%s): %w", str, err)
 	}
 
-	tableQuery, tableArgs, errs := c.table.Expr()
-	if len(errs) != 0 {
-		return "", nil, fmt.Errorf("table expr: %w", errs[0])
+	tableQuery, tableArgs, err := c.tableExpr()
+	if err != nil {
+		return "", nil, fmt.Errorf("table expr: %w", err)
 	}
 
 	_, err = sb.WriteString(tableQuery)
@@ -209,5 +238,5 @@ func (c *UpdateContext[T]) buildQuery() (string, []ExprType, error) {
 		args = append(args, limitArgs...)
 	}
 
-	return sb.String(), args, nil
+	return c.resolveDialectExprs(sb.String(), args)
 }
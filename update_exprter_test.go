@@ -3,3 +3,7 @@ package genorm
 func (c *UpdateContext[T]) BuildQuery() (string, []ExprType, error) {
 	return c.buildQuery()
 }
+
+func (c *UpdateJoinedContext[T, B]) BuildQuery() (string, []ExprType, error) {
+	return c.buildQuery()
+}
@@ -0,0 +1,340 @@
+package genorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+/*
+UpdateJoinedContext
+UPDATE joined_table SET target.column = ... WHERE ...(MySQL)
+UPDATE target SET column = ... FROM other_table WHERE join_condition AND ...(PostgreSQL)
+*/
+type UpdateJoinedContext[T JoinedTable, B BasicTable] struct {
+	*Context[T]
+	target         B
+	assignExprs    []*TableAssignExpr[B]
+	whereCondition whereConditionClause[T]
+}
+
+// JoinedAssignExpr target_table.column = expression of the joined table
+type JoinedAssignExpr[T JoinedTable, B BasicTable] struct {
+	assignExpr *TableAssignExpr[B]
+}
+
+/*
+AssignJoined
+target_table.column = expression of the joined table(e.g. messages.user_name = users.name).
+Use it with UpdateJoinedContext.SetJoined.
+*/
+func AssignJoined[T JoinedTable, B BasicTable, S ExprType](
+	column TypedTableColumns[B, S],
+	expr TypedTableExpr[T, S],
+) *JoinedAssignExpr[T, B] {
+	if column == nil || expr == nil {
+		return &JoinedAssignExpr[T, B]{
+			assignExpr: &TableAssignExpr[B]{
+				errs: []error{errors.New("AssignJoined: nil expression")},
+			},
+		}
+	}
+
+	columnQuery, columnArgs, columnErrs := column.Expr()
+	exprQuery, exprArgs, exprErrs := expr.Expr()
+	if len(columnErrs) != 0 || len(exprErrs) != 0 {
+		return &JoinedAssignExpr[T, B]{
+			assignExpr: &TableAssignExpr[B]{
+				errs: append(columnErrs, exprErrs...),
+			},
+		}
+	}
+
+	return &JoinedAssignExpr[T, B]{
+		assignExpr: &TableAssignExpr[B]{
+			query:      fmt.Sprintf("%s = %s", columnQuery, exprQuery),
+			column:     column,
+			valueQuery: exprQuery,
+			args:       append(columnArgs, exprArgs...),
+		},
+	}
+}
+
+// UpdateJoined update the rows of target, which is one of the base tables of table
+func UpdateJoined[T JoinedTable, B BasicTable](table T, target B) *UpdateJoinedContext[T, B] {
+	ctx := newContext(table)
+
+	err := validateJoinedTarget(table, target)
+	if err != nil {
+		ctx.addError(fmt.Errorf("target: %w", err))
+	}
+
+	return &UpdateJoinedContext[T, B]{
+		Context: ctx,
+		target:  target,
+	}
+}
+
+func (c *UpdateJoinedContext[T, B]) clone() *UpdateJoinedContext[T, B] {
+	clone := *c
+	clone.Context = c.Context.clone()
+
+	return &clone
+}
+
+// Set only the columns of the target table can be assigned(use SetJoined to assign the expressions of the joined table)
+func (c *UpdateJoinedContext[T, B]) Set(assignExprs ...*TableAssignExpr[B]) *UpdateJoinedContext[T, B] {
+	c = c.clone()
+
+	if len(assignExprs) == 0 {
+		c.addError(errors.New("no assign expressions"))
+		return c
+	}
+
+	c.assignExprs = append(slices.Clip(c.assignExprs), assignExprs...)
+
+	return c
+}
+
+// SetJoined assign the expressions of the joined table to the columns of the target table
+func (c *UpdateJoinedContext[T, B]) SetJoined(assignExprs ...*JoinedAssignExpr[T, B]) *UpdateJoinedContext[T, B] {
+	c = c.clone()
+
+	if len(assignExprs) == 0 {
+		c.addError(errors.New("no assign expressions"))
+		return c
+	}
+
+	c.assignExprs = slices.Clip(c.assignExprs)
+	for _, assignExpr := range assignExprs {
+		if assignExpr == nil {
+			c.addError(errors.New("nil assign expression"))
+			return c
+		}
+
+		c.assignExprs = append(c.assignExprs, assignExpr.assignExpr)
+	}
+
+	return c
+}
+
+func (c *UpdateJoinedContext[T, B]) Where(
+	condition TypedTableExpr[T, WrappedPrimitive[bool]],
+) *UpdateJoinedContext[T, B] {
+	c = c.clone()
+
+	err := c.whereCondition.set(condition)
+	if err != nil {
+		c.addError(fmt.Errorf("where condition: %w", err))
+	}
+
+	return c
+}
+
+func (c *UpdateJoinedContext[T, B]) OrWhere(
+	condition TypedTableExpr[T, WrappedPrimitive[bool]],
+) *UpdateJoinedContext[T, B] {
+	c = c.clone()
+
+	err := c.whereCondition.or(condition)
+	if err != nil {
+		c.addError(fmt.Errorf("where condition: %w", err))
+	}
+
+	return c
+}
+
+func (c *UpdateJoinedContext[T, B]) Dialect(dialect Dialect) *UpdateJoinedContext[T, B] {
+	c = c.clone()
+
+	c.setDialect(dialect)
+
+	return c
+}
+
+func (c *UpdateJoinedContext[T, B]) DoCtx(ctx context.Context, db DB) (rowsAffected int64, err error) {
+	errs := c.Errors()
+	if len(errs) != 0 {
+		return 0, errs[0]
+	}
+
+	query, exprArgs, err := c.buildQuery()
+	if err != nil {
+		return 0, fmt.Errorf("build query: %w", err)
+	}
+
+	args := make([]any, 0, len(exprArgs))
+	for _, arg := range exprArgs {
+		args = append(args, arg)
+	}
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("exec: %w", err)
+	}
+
+	rowsAffected, err = result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+func (c *UpdateJoinedContext[T, B]) Do(db DB) (rowsAffected int64, err error) {
+	return c.DoCtx(context.Background(), db)
+}
+
+func (c *UpdateJoinedContext[T, B]) buildQuery() (string, []ExprType, error) {
+	if len(c.assignExprs) == 0 {
+		return "", nil, errors.New("no assignment")
+	}
+
+	if c.dialect == PostgreSQL {
+		return c.buildFromQuery()
+	}
+
+	args := []ExprType{}
+
+	sb := strings.Builder{}
+
+	str := "UPDATE "
+	_, err := sb.WriteString(str)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+	}
+
+	tableQuery, tableArgs, err := c.tableExpr()
+	if err != nil {
+		return "", nil, fmt.Errorf("table expr: %w", err)
+	}
+
+	_, err = sb.WriteString(tableQuery)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", tableQuery, err)
+	}
+
+	args = append(args, tableArgs...)
+
+	assignments := make([]string, 0, len(c.assignExprs))
+	for _, expr := range c.assignExprs {
+		assignmentQuery, assignmentArgs, errs := expr.AssignExpr()
+		if len(errs) != 0 {
+			return "", nil, errs[0]
+		}
+
+		assignments = append(assignments, assignmentQuery)
+		args = append(args, assignmentArgs...)
+	}
+
+	str = " SET " + strings.Join(assignments, ", ")
+	_, err = sb.WriteString(str)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+	}
+
+	if c.whereCondition.exists() {
+		whereQuery, whereArgs, err := c.whereCondition.getExpr()
+		if err != nil {
+			return "", nil, fmt.Errorf("where condition: %w", err)
+		}
+
+		str = " WHERE "
+		_, err = sb.WriteString(str)
+		if err != nil {
+			return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+		}
+
+		_, err = sb.WriteString(whereQuery)
+		if err != nil {
+			return "", nil, fmt.Errorf("write string(%s): %w", whereQuery, err)
+		}
+
+		args = append(args, whereArgs...)
+	}
+
+	return c.resolveDialectExprs(sb.String(), args)
+}
+
+// buildFromQuery UPDATE target SET column = ... FROM other_table WHERE join_condition AND ...
+func (c *UpdateJoinedContext[T, B]) buildFromQuery() (string, []ExprType, error) {
+	args := []ExprType{}
+
+	sb := strings.Builder{}
+
+	str := "UPDATE "
+	_, err := sb.WriteString(str)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+	}
+
+	targetQuery, targetArgs, errs := c.target.Expr()
+	if len(errs) != 0 {
+		return "", nil, fmt.Errorf("target: %w", errs[0])
+	}
+
+	_, err = sb.WriteString(targetQuery)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", targetQuery, err)
+	}
+
+	args = append(args, targetArgs...)
+
+	assignments := make([]string, 0, len(c.assignExprs))
+	for _, expr := range c.assignExprs {
+		assignmentQuery, assignmentArgs, errs := expr.unqualifiedAssignExpr()
+		if len(errs) != 0 {
+			return "", nil, errs[0]
+		}
+
+		assignments = append(assignments, assignmentQuery)
+		args = append(args, assignmentArgs...)
+	}
+
+	str = " SET " + strings.Join(assignments, ", ")
+	_, err = sb.WriteString(str)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+	}
+
+	fromQuery, fromArgs, onQuery, onArgs, err := detachTarget(c.table, c.target)
+	if err != nil {
+		return "", nil, fmt.Errorf("from table: %w", err)
+	}
+
+	str = " FROM " + fromQuery
+	_, err = sb.WriteString(str)
+	if err != nil {
+		return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+	}
+
+	args = append(args, fromArgs...)
+
+	conditions := []string{}
+	if len(onQuery) != 0 {
+		conditions = append(conditions, onQuery)
+		args = append(args, onArgs...)
+	}
+
+	if c.whereCondition.exists() {
+		whereQuery, whereArgs, err := c.whereCondition.getExpr()
+		if err != nil {
+			return "", nil, fmt.Errorf("where condition: %w", err)
+		}
+
+		conditions = append(conditions, whereQuery)
+		args = append(args, whereArgs...)
+	}
+
+	if len(conditions) != 0 {
+		str = " WHERE " + strings.Join(conditions, " AND ")
+		_, err = sb.WriteString(str)
+		if err != nil {
+			return "", nil, fmt.Errorf("write string(%s): %w", str, err)
+		}
+	}
+
+	return c.resolveDialectExprs(sb.String(), args)
+}
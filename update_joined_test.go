@@ -0,0 +1,181 @@
+package genorm_test
+
+import (
+	"testing"
+
+	"github.com/mazrean/genorm"
+	"github.com/mazrean/genorm/relation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateJoinedBuildQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		joinedTable func(*joinedTestPair) *joinedTestPairTable
+		dialect     genorm.Dialect
+		noAssign    bool
+		query       string
+		args        []genorm.ExprType
+		isError     bool
+	}{
+		{
+			description: "mysql",
+			joinedTable: joinedTestInnerJoin,
+			dialect:     genorm.MySQL,
+			query:       "UPDATE (users INNER JOIN messages ON (users.id = messages.user_id)) SET messages.user_id = ? WHERE (messages.id = ?)",
+			args:        []genorm.ExprType{genorm.Wrap[int64](2), genorm.Wrap[int64](1)},
+		},
+		{
+			description: "postgres",
+			joinedTable: joinedTestInnerJoin,
+			dialect:     genorm.PostgreSQL,
+			query:       "UPDATE messages SET user_id = ? FROM users WHERE (users.id = messages.user_id) AND (messages.id = ?)",
+			args:        []genorm.ExprType{genorm.Wrap[int64](2), genorm.Wrap[int64](1)},
+		},
+		{
+			description: "postgres cross join",
+			joinedTable: joinedTestCrossJoin,
+			dialect:     genorm.PostgreSQL,
+			query:       "UPDATE messages SET user_id = ? FROM users WHERE (messages.id = ?)",
+			args:        []genorm.ExprType{genorm.Wrap[int64](2), genorm.Wrap[int64](1)},
+		},
+		{
+			description: "postgres left join",
+			joinedTable: joinedTestLeftJoin,
+			dialect:     genorm.PostgreSQL,
+			isError:     true,
+		},
+		{
+			description: "no assignment",
+			joinedTable: joinedTestInnerJoin,
+			dialect:     genorm.MySQL,
+			noAssign:    true,
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			pair := relation.Pair(&aliasTestUser{}, &preloadTestMessage{})
+
+			builder := genorm.
+				UpdateJoined(test.joinedTable(pair), &preloadTestMessage{}).
+				Dialect(test.dialect).
+				Where(genorm.EqLit(relation.RightExpr(pair, preloadTestMessageID), genorm.Wrap[int64](1)))
+			if !test.noAssign {
+				builder = builder.Set(genorm.AssignLit(preloadTestMessageUserID, genorm.Wrap[int64](2)))
+			}
+
+			if !assert.Empty(t, builder.Errors()) {
+				return
+			}
+
+			query, args, err := builder.BuildQuery()
+			if test.isError {
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+			assert.Equal(t, test.args, args)
+		})
+	}
+}
+
+func TestUpdateJoinedSetJoined(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		dialect     genorm.Dialect
+		assignExpr  func(*joinedTestPair) *genorm.JoinedAssignExpr[*joinedTestPairTable, *preloadTestMessage]
+		query       string
+		args        []genorm.ExprType
+		isError     bool
+	}{
+		{
+			description: "mysql",
+			dialect:     genorm.MySQL,
+			assignExpr: func(pair *joinedTestPair) *genorm.JoinedAssignExpr[*joinedTestPairTable, *preloadTestMessage] {
+				return genorm.AssignJoined(preloadTestMessageUserID, relation.LeftExpr(pair, aliasTestUserID))
+			},
+			query: "UPDATE (users INNER JOIN messages ON (users.id = messages.user_id)) SET messages.user_id = users.id WHERE (messages.id = ?)",
+			args:  []genorm.ExprType{genorm.Wrap[int64](1)},
+		},
+		{
+			description: "postgres",
+			dialect:     genorm.PostgreSQL,
+			assignExpr: func(pair *joinedTestPair) *genorm.JoinedAssignExpr[*joinedTestPairTable, *preloadTestMessage] {
+				return genorm.AssignJoined(preloadTestMessageUserID, relation.LeftExpr(pair, aliasTestUserID))
+			},
+			query: "UPDATE messages SET user_id = users.id FROM users WHERE (users.id = messages.user_id) AND (messages.id = ?)",
+			args:  []genorm.ExprType{genorm.Wrap[int64](1)},
+		},
+		{
+			description: "expression with args",
+			dialect:     genorm.MySQL,
+			assignExpr: func(*joinedTestPair) *genorm.JoinedAssignExpr[*joinedTestPairTable, *preloadTestMessage] {
+				return genorm.AssignJoined(preloadTestMessageUserID, genorm.RawExpr[*joinedTestPairTable, genorm.WrappedPrimitive[int64]]("(users.id + ?)", genorm.Wrap[int64](2)))
+			},
+			query: "UPDATE (users INNER JOIN messages ON (users.id = messages.user_id)) SET messages.user_id = (users.id + ?) WHERE (messages.id = ?)",
+			args:  []genorm.ExprType{genorm.Wrap[int64](2), genorm.Wrap[int64](1)},
+		},
+		{
+			description: "nil expression",
+			dialect:     genorm.MySQL,
+			assignExpr: func(*joinedTestPair) *genorm.JoinedAssignExpr[*joinedTestPairTable, *preloadTestMessage] {
+				return genorm.AssignJoined[*joinedTestPairTable](preloadTestMessageUserID, nil)
+			},
+			isError: true,
+		},
+		{
+			description: "nil assign expression",
+			dialect:     genorm.MySQL,
+			assignExpr: func(*joinedTestPair) *genorm.JoinedAssignExpr[*joinedTestPairTable, *preloadTestMessage] {
+				return nil
+			},
+			isError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			pair := relation.Pair(&aliasTestUser{}, &preloadTestMessage{})
+
+			builder := genorm.
+				UpdateJoined(joinedTestInnerJoin(pair), &preloadTestMessage{}).
+				Dialect(test.dialect).
+				SetJoined(test.assignExpr(pair)).
+				Where(genorm.EqLit(relation.RightExpr(pair, preloadTestMessageID), genorm.Wrap[int64](1)))
+			if test.isError {
+				if len(builder.Errors()) != 0 {
+					return
+				}
+
+				_, _, err := builder.BuildQuery()
+				assert.Error(t, err)
+				return
+			}
+
+			if !assert.Empty(t, builder.Errors()) {
+				return
+			}
+
+			query, args, err := builder.BuildQuery()
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.query, query)
+			assert.Equal(t, test.args, args)
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package genorm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Window Functions
+
+// WindowDefinition OVER (PARTITION BY ... ORDER BY ... frame)
+type WindowDefinition[T Table] struct {
+	partition []TableExpr[T]
+	order     orderClause[T]
+	frame     *windowFrame
+	errs      []error
+}
+
+func Over[T Table]() *WindowDefinition[T] {
+	return &WindowDefinition[T]{}
+}
+
+func (wd *WindowDefinition[T]) PartitionBy(exprs ...TableExpr[T]) *WindowDefinition[T] {
+	if len(wd.partition) != 0 {
+		wd.errs = append(wd.errs, errors.New("partition by already set"))
+		return wd
+	}
+	if len(exprs) == 0 {
+		wd.errs = append(wd.errs, errors.New("empty partition by"))
+		return wd
+	}
+
+	wd.partition = exprs
+
+	return wd
+}
+
+func (wd *WindowDefinition[T]) OrderBy(direction OrderDirection, expr TableExpr[T]) *WindowDefinition[T] {
+	err := wd.order.add(orderItem[T]{
+		expr:      expr,
+		direction: direction,
+	})
+	if err != nil {
+		wd.errs = append(wd.errs, fmt.Errorf("order by: %w", err))
+	}
+
+	return wd
+}
+
+// Rows ROWS BETWEEN start AND end
+func (wd *WindowDefinition[T]) Rows(start FrameBound, end FrameBound) *WindowDefinition[T] {
+	return wd.setFrame(frameUnitRows, start, end)
+}
+
+// Range RANGE BETWEEN start AND end
+func (wd *WindowDefinition[T]) Range(start FrameBound, end FrameBound) *WindowDefinition[T] {
+	return wd.setFrame(frameUnitRange, start, end)
+}
+
+func (wd *WindowDefinition[T]) setFrame(unit frameUnit, start FrameBound, end FrameBound) *WindowDefinition[T] {
+	if wd.frame != nil {
+		wd.errs = append(wd.errs, errors.New("frame already set"))
+		return wd
+	}
+
+	frame := &windowFrame{
+		unit:  unit,
+		start: start,
+		end:   end,
+	}
+	err := frame.validate()
+	if err != nil {
+		wd.errs = append(wd.errs, fmt.Errorf("frame: %w", err))
+		return wd
+	}
+
+	wd.frame = frame
+
+	return wd
+}
+
+func (wd *WindowDefinition[T]) getExpr() (string, []ExprType, error) {
+	if wd == nil {
+		return "", nil, errors.New("window definition is nil")
+	}
+
+	if len(wd.errs) != 0 {
+		return "", nil, wd.errs[0]
+	}
+
+	queries := []string{}
+	args := []ExprType{}
+
+	if len(wd.partition) != 0 {
+		partitionQueries := make([]string, 0, len(wd.partition))
+		for _, expr := range wd.partition {
+			if expr == nil {
+				return "", nil, errors.New("partition by: expr is nil")
+			}
+
+			partitionQuery, partitionArgs, errs := expr.Expr()
+			if len(errs) != 0 {
+				return "", nil, fmt.Errorf("partition by: %w", errs[0])
+			}
+
+			partitionQueries = append(partitionQueries, partitionQuery)
+			args = append(args, partitionArgs...)
+		}
+
+		queries = append(queries, "PARTITION BY "+strings.Join(partitionQueries, ", "))
+	}
+
+	if wd.order.exists() {
+		orderQuery, orderArgs, err := wd.order.getExpr()
+		if err != nil {
+			return "", nil, fmt.Errorf("order by: %w", err)
+		}
+
+		queries = append(queries, orderQuery)
+		args = append(args, orderArgs...)
+	}
+
+	if wd.frame != nil {
+		frameQuery, err := wd.frame.getExpr()
+		if err != nil {
+			return "", nil, fmt.Errorf("frame: %w", err)
+		}
+
+		queries = append(queries, frameQuery)
+	}
+
+	return fmt.Sprintf("OVER (%s)", strings.Join(queries, " ")), args, nil
+}
+
+type frameUnit uint8
+
+const (
+	frameUnitRows frameUnit = iota + 1
+	frameUnitRange
+)
+
+type frameBoundType uint8
+
+const (
+	frameBoundUnboundedPreceding frameBoundType = iota + 1
+	frameBoundPreceding
+	frameBoundCurrentRow
+	frameBoundFollowing
+	frameBoundUnboundedFollowing
+)
+
+// FrameBound bound of the window frame
+type FrameBound struct {
+	boundType frameBoundType
+	offset    uint64
+}
+
+var (
+	// UnboundedPreceding UNBOUNDED PRECEDING
+	UnboundedPreceding = FrameBound{boundType: frameBoundUnboundedPreceding}
+	// CurrentRow CURRENT ROW
+	CurrentRow = FrameBound{boundType: frameBoundCurrentRow}
+	// UnboundedFollowing UNBOUNDED FOLLOWING
+	UnboundedFollowing = FrameBound{boundType: frameBoundUnboundedFollowing}
+)
+
+// Preceding offset PRECEDING
+func Preceding(offset uint64) FrameBound {
+	return FrameBound{
+		boundType: frameBoundPreceding,
+		offset:    offset,
+	}
+}
+
+// Following offset FOLLOWING
+func Following(offset uint64) FrameBound {
+	return FrameBound{
+		boundType: frameBoundFollowing,
+		offset:    offset,
+	}
+}
+
+func (fb FrameBound) getExpr() (string, error) {
+	switch fb.boundType {
+	case frameBoundUnboundedPreceding:
+		return "UNBOUNDED PRECEDING", nil
+	case frameBoundPreceding:
+		return fmt.Sprintf("%d PRECEDING", fb.offset), nil
+	case frameBoundCurrentRow:
+		return "CURRENT ROW", nil
+	case frameBoundFollowing:
+		return fmt.Sprintf("%d FOLLOWING", fb.offset), nil
+	case frameBoundUnboundedFollowing:
+		return "UNBOUNDED FOLLOWING", nil
+	}
+
+	return "", fmt.Errorf("invalid frame bound: %d", fb.boundType)
+}
+
+type windowFrame struct {
+	unit  frameUnit
+	start FrameBound
+	end   FrameBound
+}
+
+func (wf *windowFrame) validate() error {
+	if wf.unit != frameUnitRows && wf.unit != frameUnitRange {
+		return errors.New("invalid frame unit")
+	}
+
+	if wf.start.boundType < frameBoundUnboundedPreceding || wf.start.boundType > frameBoundUnboundedFollowing {
+		return errors.New("invalid frame start")
+	}
+	if wf.end.boundType < frameBoundUnboundedPreceding || wf.end.boundType > frameBoundUnboundedFollowing {
+		return errors.New("invalid frame end")
+	}
+
+	if wf.start.boundType == frameBoundUnboundedFollowing {
+		return errors.New("frame start cannot be UNBOUNDED FOLLOWING")
+	}
+	if wf.end.boundType == frameBoundUnboundedPreceding {
+		return errors.New("frame end cannot be UNBOUNDED PRECEDING")
+	}
+	if wf.start.boundType > wf.end.boundType {
+		return errors.New("frame start must not be after frame end")
+	}
+
+	return nil
+}
+
+func (wf *windowFrame) getExpr() (string, error) {
+	var unitQuery string
+	switch wf.unit {
+	case frameUnitRows:
+		unitQuery = "ROWS"
+	case frameUnitRange:
+		unitQuery = "RANGE"
+	default:
+		return "", fmt.Errorf("invalid frame unit: %d", wf.unit)
+	}
+
+	startQuery, err := wf.start.getExpr()
+	if err != nil {
+		return "", fmt.Errorf("frame start: %w", err)
+	}
+
+	endQuery, err := wf.end.getExpr()
+	if err != nil {
+		return "", fmt.Errorf("frame end: %w", err)
+	}
+
+	return fmt.Sprintf("%s BETWEEN %s AND %s", unitQuery, startQuery, endQuery), nil
+}
+
+// RowNumber ROW_NUMBER() OVER (...)
+func RowNumber[T Table](over *WindowDefinition[T]) TypedTableExpr[T, WrappedPrimitive[int64]] {
+	return window[T, WrappedPrimitive[int64]]("ROW_NUMBER()", nil, over)
+}
+
+// Rank RANK() OVER (...)
+func Rank[T Table](over *WindowDefinition[T]) TypedTableExpr[T, WrappedPrimitive[int64]] {
+	return window[T, WrappedPrimitive[int64]]("RANK()", nil, over)
+}
+
+// DenseRank DENSE_RANK() OVER (...)
+func DenseRank[T Table](over *WindowDefinition[T]) TypedTableExpr[T, WrappedPrimitive[int64]] {
+	return window[T, WrappedPrimitive[int64]]("DENSE_RANK()", nil, over)
+}
+
+// Lag LAG(expr, offset) OVER (...)
+func Lag[T Table, S ExprType](expr TypedTableExpr[T, S], offset uint64, over *WindowDefinition[T]) TypedTableExpr[T, S] {
+	return windowWithExpr[T, S, S]("LAG", expr, fmt.Sprintf(", %d", offset), over)
+}
+
+// Lead LEAD(expr, offset) OVER (...)
+func Lead[T Table, S ExprType](expr TypedTableExpr[T, S], offset uint64, over *WindowDefinition[T]) TypedTableExpr[T, S] {
+	return windowWithExpr[T, S, S]("LEAD", expr, fmt.Sprintf(", %d", offset), over)
+}
+
+// FirstValue FIRST_VALUE(expr) OVER (...)
+func FirstValue[T Table, S ExprType](expr TypedTableExpr[T, S], over *WindowDefinition[T]) TypedTableExpr[T, S] {
+	return windowWithExpr[T, S, S]("FIRST_VALUE", expr, "", over)
+}
+
+// SumOver SUM(expr) OVER (...)
+func SumOver[T Table, S ExprIntegerPrimitive](expr TypedTableExpr[T, WrappedPrimitive[S]], over *WindowDefinition[T]) TypedTableExpr[T, WrappedPrimitive[int64]] {
+	return windowWithExpr[T, WrappedPrimitive[S], WrappedPrimitive[int64]]("SUM", expr, "", over)
+}
+
+// AvgOver AVG(expr) OVER (...)
+func AvgOver[T Table, S ExprType](expr TypedTableExpr[T, S], over *WindowDefinition[T]) TypedTableExpr[T, WrappedPrimitive[float64]] {
+	return windowWithExpr[T, S, WrappedPrimitive[float64]]("AVG", expr, "", over)
+}
+
+func windowWithExpr[T Table, S ExprType, U ExprType](funcName string, expr TypedTableExpr[T, S], extraArgsQuery string, over *WindowDefinition[T]) TypedTableExpr[T, U] {
+	if expr == nil {
+		return &ExprStruct[T, U]{
+			errs: []error{fmt.Errorf("%s expr is nil", strings.ToLower(funcName))},
+		}
+	}
+
+	query, args, errs := expr.Expr()
+	if len(errs) != 0 {
+		return &ExprStruct[T, U]{
+			errs: errs,
+		}
+	}
+
+	return window[T, U](fmt.Sprintf("%s(%s%s)", funcName, query, extraArgsQuery), args, over)
+}
+
+func window[T Table, S ExprType](funcQuery string, funcArgs []ExprType, over *WindowDefinition[T]) TypedTableExpr[T, S] {
+	overQuery, overArgs, err := over.getExpr()
+	if err != nil {
+		return &ExprStruct[T, S]{
+			errs: []error{fmt.Errorf("over: %w", err)},
+		}
+	}
+
+	args := make([]ExprType, 0, len(funcArgs)+len(overArgs))
+	args = append(args, funcArgs...)
+	args = append(args, overArgs...)
+
+	return &ExprStruct[T, S]{
+		query: fmt.Sprintf("%s %s", funcQuery, overQuery),
+		args:  args,
+	}
+}
@@ -0,0 +1,338 @@
+package genorm_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mazrean/genorm"
+	"github.com/mazrean/genorm/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindowDefinition(t *testing.T) {
+	t.Parallel()
+
+	type orderItem struct {
+		direction genorm.OrderDirection
+		query     string
+		args      []genorm.ExprType
+	}
+
+	type frame struct {
+		isRange bool
+		start   genorm.FrameBound
+		end     genorm.FrameBound
+	}
+
+	tests := []struct {
+		description    string
+		partitionExprs []string
+		partitionArgs  [][]genorm.ExprType
+		partitionErrs  []error
+		emptyPartition bool
+		orderItems     []orderItem
+		frames         []frame
+		overIsNil      bool
+		expectedQuery  string
+		expectedArgs   []genorm.ExprType
+		isError        bool
+	}{
+		{
+			description:   "empty",
+			expectedQuery: "ROW_NUMBER() OVER ()",
+			expectedArgs:  []genorm.ExprType{},
+		},
+		{
+			description:    "partition by",
+			partitionExprs: []string{"hoge.huga", "(hoge.piyo = ?)"},
+			partitionArgs:  [][]genorm.ExprType{nil, {genorm.Wrap(1)}},
+			expectedQuery:  "ROW_NUMBER() OVER (PARTITION BY hoge.huga, (hoge.piyo = ?))",
+			expectedArgs:   []genorm.ExprType{genorm.Wrap(1)},
+		},
+		{
+			description: "order by",
+			orderItems: []orderItem{
+				{direction: genorm.Desc, query: "hoge.huga"},
+				{direction: genorm.Asc, query: "(hoge.piyo = ?)", args: []genorm.ExprType{genorm.Wrap(2)}},
+			},
+			expectedQuery: "ROW_NUMBER() OVER (ORDER BY hoge.huga DESC, (hoge.piyo = ?) ASC)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(2)},
+		},
+		{
+			description:    "partition by, order by and rows frame",
+			partitionExprs: []string{"(hoge.huga = ?)"},
+			partitionArgs:  [][]genorm.ExprType{{genorm.Wrap(1)}},
+			orderItems: []orderItem{
+				{direction: genorm.Asc, query: "(hoge.piyo = ?)", args: []genorm.ExprType{genorm.Wrap(2)}},
+			},
+			frames: []frame{
+				{start: genorm.UnboundedPreceding, end: genorm.CurrentRow},
+			},
+			expectedQuery: "ROW_NUMBER() OVER (PARTITION BY (hoge.huga = ?) ORDER BY (hoge.piyo = ?) ASC ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(1), genorm.Wrap(2)},
+		},
+		{
+			description: "range frame with offsets",
+			frames: []frame{
+				{isRange: true, start: genorm.Preceding(3), end: genorm.Following(2)},
+			},
+			expectedQuery: "ROW_NUMBER() OVER (RANGE BETWEEN 3 PRECEDING AND 2 FOLLOWING)",
+			expectedArgs:  []genorm.ExprType{},
+		},
+		{
+			description: "frame to unbounded following",
+			frames: []frame{
+				{start: genorm.CurrentRow, end: genorm.UnboundedFollowing},
+			},
+			expectedQuery: "ROW_NUMBER() OVER (ROWS BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING)",
+			expectedArgs:  []genorm.ExprType{},
+		},
+		{
+			description: "frame start unbounded following",
+			frames: []frame{
+				{start: genorm.UnboundedFollowing, end: genorm.UnboundedFollowing},
+			},
+			isError: true,
+		},
+		{
+			description: "frame end unbounded preceding",
+			frames: []frame{
+				{start: genorm.UnboundedPreceding, end: genorm.UnboundedPreceding},
+			},
+			isError: true,
+		},
+		{
+			description: "frame start after end",
+			frames: []frame{
+				{start: genorm.Following(1), end: genorm.CurrentRow},
+			},
+			isError: true,
+		},
+		{
+			description: "invalid frame bound",
+			frames: []frame{
+				{start: genorm.FrameBound{}, end: genorm.CurrentRow},
+			},
+			isError: true,
+		},
+		{
+			description: "frame already set",
+			frames: []frame{
+				{start: genorm.UnboundedPreceding, end: genorm.CurrentRow},
+				{start: genorm.UnboundedPreceding, end: genorm.CurrentRow},
+			},
+			isError: true,
+		},
+		{
+			description:    "empty partition by",
+			emptyPartition: true,
+			isError:        true,
+		},
+		{
+			description:    "partition expr error",
+			partitionExprs: []string{"hoge.huga"},
+			partitionArgs:  [][]genorm.ExprType{nil},
+			partitionErrs:  []error{errors.New("partition error")},
+			isError:        true,
+		},
+		{
+			description: "invalid order direction",
+			orderItems: []orderItem{
+				{direction: 0, query: "hoge.huga"},
+			},
+			isError: true,
+		},
+		{
+			description: "nil over",
+			overIsNil:   true,
+			isError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var over *genorm.WindowDefinition[*mock.MockTable]
+			if !test.overIsNil {
+				over = genorm.Over[*mock.MockTable]()
+			}
+
+			if len(test.partitionExprs) != 0 || test.emptyPartition {
+				exprs := make([]genorm.TableExpr[*mock.MockTable], 0, len(test.partitionExprs))
+				for i, query := range test.partitionExprs {
+					mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+					mockExpr.
+						EXPECT().
+						Expr().
+						Return(query, test.partitionArgs[i], test.partitionErrs).
+						AnyTimes()
+
+					exprs = append(exprs, mockExpr)
+				}
+
+				over = over.PartitionBy(exprs...)
+			}
+
+			for _, item := range test.orderItems {
+				mockExpr := mock.NewMockTypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[bool]](ctrl)
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return(item.query, item.args, nil).
+					AnyTimes()
+
+				over = over.OrderBy(item.direction, mockExpr)
+			}
+
+			for _, frame := range test.frames {
+				if frame.isRange {
+					over = over.Range(frame.start, frame.end)
+				} else {
+					over = over.Rows(frame.start, frame.end)
+				}
+			}
+
+			query, args, errs := genorm.RowNumber(over).Expr()
+			if test.isError {
+				assert.NotEmpty(t, errs)
+				return
+			}
+
+			if !assert.Nil(t, errs) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
+
+func TestWindowFunctions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description   string
+		window        func(genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]], *genorm.WindowDefinition[*mock.MockTable]) genorm.Expr
+		exprIsNil     bool
+		exprErrs      []error
+		expectedQuery string
+		expectedArgs  []genorm.ExprType
+		isError       bool
+	}{
+		{
+			description: "rank",
+			window: func(_ genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]], over *genorm.WindowDefinition[*mock.MockTable]) genorm.Expr {
+				return genorm.Rank(over)
+			},
+			expectedQuery: "RANK() OVER (ORDER BY hoge.piyo DESC)",
+			expectedArgs:  []genorm.ExprType{},
+		},
+		{
+			description: "dense rank",
+			window: func(_ genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]], over *genorm.WindowDefinition[*mock.MockTable]) genorm.Expr {
+				return genorm.DenseRank(over)
+			},
+			expectedQuery: "DENSE_RANK() OVER (ORDER BY hoge.piyo DESC)",
+			expectedArgs:  []genorm.ExprType{},
+		},
+		{
+			description: "lag",
+			window: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]], over *genorm.WindowDefinition[*mock.MockTable]) genorm.Expr {
+				return genorm.Lag(expr, 1, over)
+			},
+			expectedQuery: "LAG((hoge.huga + ?), 1) OVER (ORDER BY hoge.piyo DESC)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(int64(1))},
+		},
+		{
+			description: "lead",
+			window: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]], over *genorm.WindowDefinition[*mock.MockTable]) genorm.Expr {
+				return genorm.Lead(expr, 2, over)
+			},
+			expectedQuery: "LEAD((hoge.huga + ?), 2) OVER (ORDER BY hoge.piyo DESC)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(int64(1))},
+		},
+		{
+			description: "first value",
+			window: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]], over *genorm.WindowDefinition[*mock.MockTable]) genorm.Expr {
+				return genorm.FirstValue(expr, over)
+			},
+			expectedQuery: "FIRST_VALUE((hoge.huga + ?)) OVER (ORDER BY hoge.piyo DESC)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(int64(1))},
+		},
+		{
+			description: "sum over",
+			window: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]], over *genorm.WindowDefinition[*mock.MockTable]) genorm.Expr {
+				return genorm.SumOver(expr, over)
+			},
+			expectedQuery: "SUM((hoge.huga + ?)) OVER (ORDER BY hoge.piyo DESC)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(int64(1))},
+		},
+		{
+			description: "avg over",
+			window: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]], over *genorm.WindowDefinition[*mock.MockTable]) genorm.Expr {
+				return genorm.AvgOver(expr, over)
+			},
+			expectedQuery: "AVG((hoge.huga + ?)) OVER (ORDER BY hoge.piyo DESC)",
+			expectedArgs:  []genorm.ExprType{genorm.Wrap(int64(1))},
+		},
+		{
+			description: "nil expr",
+			window: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]], over *genorm.WindowDefinition[*mock.MockTable]) genorm.Expr {
+				return genorm.Lag(expr, 1, over)
+			},
+			exprIsNil: true,
+			isError:   true,
+		},
+		{
+			description: "expr error",
+			window: func(expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]], over *genorm.WindowDefinition[*mock.MockTable]) genorm.Expr {
+				return genorm.SumOver(expr, over)
+			},
+			exprErrs: []error{errors.New("expr error")},
+			isError:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var expr genorm.TypedTableExpr[*mock.MockTable, genorm.WrappedPrimitive[int64]]
+			if !test.exprIsNil {
+				mockExpr := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[int64]](ctrl)
+				expr = mockExpr
+
+				mockExpr.
+					EXPECT().
+					Expr().
+					Return("(hoge.huga + ?)", []genorm.ExprType{genorm.Wrap(int64(1))}, test.exprErrs).
+					AnyTimes()
+			}
+
+			orderExpr := mock.NewMockTypedTableColumn[*mock.MockTable, genorm.WrappedPrimitive[int64]](ctrl)
+			orderExpr.
+				EXPECT().
+				Expr().
+				Return("hoge.piyo", nil, nil).
+				AnyTimes()
+
+			over := genorm.Over[*mock.MockTable]().OrderBy(genorm.Desc, orderExpr)
+
+			query, args, errs := test.window(expr, over).Expr()
+			if test.isError {
+				assert.NotEmpty(t, errs)
+				return
+			}
+
+			if !assert.Nil(t, errs) {
+				return
+			}
+
+			assert.Equal(t, test.expectedQuery, query)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}